@@ -0,0 +1,236 @@
+package adaptivelimiter
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// ErrLimitExceeded is returned when an execution exceeds the current concurrency limit of an AdaptiveLimiter.
+var ErrLimitExceeded = errors.New("adaptive concurrency limit exceeded")
+
+/*
+AdaptiveLimiter is a Policy that limits concurrent executions to a limit which adapts to the observed latency of
+executions, using an algorithm based on TCP Vegas. Rather than requiring a concurrency limit to be tuned in advance, as
+with a Bulkhead, the limit is increased while latencies are low and decreased when latencies increase, which indicates
+that requests are queueing in a downstream dependency.
+
+The limit is adjusted after each execution by estimating the number of queued requests as:
+
+	queue = limit * (1 - minLatency/latency)
+
+where minLatency is the lowest latency observed recently, which approximates the latency of an execution under no load.
+If the queue is below a lower threshold, the limit is increased by 1, and if the queue is above an upper threshold, the
+limit is decreased by 1, within the min and max limits. The minLatency is periodically reset so that it can adapt to
+changes in the dependency's baseline latency.
+
+Executions that would exceed the current limit are rejected with ErrLimitExceeded. Executions that are canceled do not
+adjust the limit.
+
+This type is concurrency safe.
+*/
+type AdaptiveLimiter[R any] interface {
+	failsafe.Policy[R]
+
+	// Limit returns the current concurrency limit.
+	Limit() int
+
+	// Inflight returns the number of executions that are currently in progress.
+	Inflight() int
+}
+
+/*
+AdaptiveLimiterBuilder builds AdaptiveLimiter instances.
+
+This type is not concurrency safe.
+*/
+type AdaptiveLimiterBuilder[R any] interface {
+	// WithLimits configures the min and max concurrency limits that the limit can be adjusted between. The defaults are 1
+	// and 200.
+	WithLimits(minLimit uint, maxLimit uint) AdaptiveLimiterBuilder[R]
+
+	// WithInitialLimit configures the concurrency limit that the AdaptiveLimiter starts with. The default is 20.
+	WithInitialLimit(initialLimit uint) AdaptiveLimiterBuilder[R]
+
+	// WithQueueThresholds configures the estimated number of queued requests below which the limit is increased, and above
+	// which the limit is decreased. The defaults are 3 and 6.
+	WithQueueThresholds(lower float64, upper float64) AdaptiveLimiterBuilder[R]
+
+	// OnLimitChanged registers the listener to be called when the limit changes.
+	OnLimitChanged(listener func(event LimitChangedEvent)) AdaptiveLimiterBuilder[R]
+
+	// OnLimitExceeded registers the listener to be called when an execution is rejected because the limit was exceeded.
+	OnLimitExceeded(listener func(event failsafe.ExecutionEvent[R])) AdaptiveLimiterBuilder[R]
+
+	// Build returns a new AdaptiveLimiter using the builder's configuration.
+	Build() AdaptiveLimiter[R]
+}
+
+// LimitChangedEvent indicates an AdaptiveLimiter's limit has changed.
+type LimitChangedEvent struct {
+	OldLimit int
+	NewLimit int
+}
+
+// The number of latency samples, as a multiple of the limit, after which the min latency is reset.
+const minLatencyResetFactor = 30
+
+type adaptiveLimiterConfig[R any] struct {
+	minLimit        uint
+	maxLimit        uint
+	initialLimit    uint
+	lowerQueue      float64
+	upperQueue      float64
+	onLimitChanged  func(LimitChangedEvent)
+	onLimitExceeded func(failsafe.ExecutionEvent[R])
+}
+
+var _ AdaptiveLimiterBuilder[any] = &adaptiveLimiterConfig[any]{}
+
+type adaptiveLimiter[R any] struct {
+	config *adaptiveLimiterConfig[R]
+	mtx    sync.Mutex
+
+	// Guarded by mtx
+	limit        int
+	inflight     int
+	minLatency   time.Duration
+	samplesCount int // The number of samples since the min latency was reset
+}
+
+// WithDefaults returns a new AdaptiveLimiter for execution result type R with a min limit of 1, a max limit of 200, and
+// an initial limit of 20 concurrent executions.
+func WithDefaults[R any]() AdaptiveLimiter[R] {
+	return Builder[R]().Build()
+}
+
+// Builder returns an AdaptiveLimiterBuilder for execution result type R, which by default will build an AdaptiveLimiter
+// with a min limit of 1, a max limit of 200, and an initial limit of 20 concurrent executions, which increases the limit
+// when fewer than 3 requests are estimated to be queued, and decreases the limit when more than 6 are.
+func Builder[R any]() AdaptiveLimiterBuilder[R] {
+	return &adaptiveLimiterConfig[R]{
+		minLimit:     1,
+		maxLimit:     200,
+		initialLimit: 20,
+		lowerQueue:   3,
+		upperQueue:   6,
+	}
+}
+
+func (c *adaptiveLimiterConfig[R]) WithLimits(minLimit uint, maxLimit uint) AdaptiveLimiterBuilder[R] {
+	c.minLimit = minLimit
+	c.maxLimit = maxLimit
+	return c
+}
+
+func (c *adaptiveLimiterConfig[R]) WithInitialLimit(initialLimit uint) AdaptiveLimiterBuilder[R] {
+	c.initialLimit = initialLimit
+	return c
+}
+
+func (c *adaptiveLimiterConfig[R]) WithQueueThresholds(lower float64, upper float64) AdaptiveLimiterBuilder[R] {
+	c.lowerQueue = lower
+	c.upperQueue = upper
+	return c
+}
+
+func (c *adaptiveLimiterConfig[R]) OnLimitChanged(listener func(event LimitChangedEvent)) AdaptiveLimiterBuilder[R] {
+	c.onLimitChanged = listener
+	return c
+}
+
+func (c *adaptiveLimiterConfig[R]) OnLimitExceeded(listener func(event failsafe.ExecutionEvent[R])) AdaptiveLimiterBuilder[R] {
+	c.onLimitExceeded = listener
+	return c
+}
+
+func (c *adaptiveLimiterConfig[R]) Build() AdaptiveLimiter[R] {
+	alCopy := *c
+	return &adaptiveLimiter[R]{
+		config: &alCopy,
+		limit:  int(min(max(alCopy.initialLimit, alCopy.minLimit), alCopy.maxLimit)),
+	}
+}
+
+func (l *adaptiveLimiter[R]) Limit() int {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.limit
+}
+
+func (l *adaptiveLimiter[R]) Inflight() int {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.inflight
+}
+
+// tryAcquirePermit acquires a permit if the number of inflight executions is below the limit, returning whether a permit
+// was acquired.
+func (l *adaptiveLimiter[R]) tryAcquirePermit() bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if l.inflight >= l.limit {
+		return false
+	}
+	l.inflight++
+	return true
+}
+
+// releasePermit releases a permit, and if the latency is not -1, adjusts the limit based on it.
+func (l *adaptiveLimiter[R]) releasePermit(latency time.Duration) {
+	l.mtx.Lock()
+	l.inflight--
+	if latency == -1 {
+		l.mtx.Unlock()
+		return
+	}
+	oldLimit := l.limit
+	l.limit = l.adjustLimit(latency)
+	newLimit := l.limit
+	l.mtx.Unlock()
+
+	if newLimit != oldLimit && l.config.onLimitChanged != nil {
+		l.config.onLimitChanged(LimitChangedEvent{
+			OldLimit: oldLimit,
+			NewLimit: newLimit,
+		})
+	}
+}
+
+// adjustLimit records the latency and returns the adjusted limit.
+//
+// Requires external locking.
+func (l *adaptiveLimiter[R]) adjustLimit(latency time.Duration) int {
+	latency = max(latency, 1)
+	l.samplesCount++
+	if l.samplesCount > minLatencyResetFactor*l.limit {
+		// Reset the min latency so it can adapt to changes in the baseline latency
+		l.minLatency = 0
+		l.samplesCount = 1
+	}
+	if l.minLatency == 0 || latency < l.minLatency {
+		l.minLatency = latency
+	}
+
+	limit := l.limit
+	queue := float64(limit) * (1 - float64(l.minLatency)/float64(latency))
+	if queue < l.config.lowerQueue {
+		limit++
+	} else if queue > l.config.upperQueue {
+		limit--
+	}
+	return int(math.Min(math.Max(float64(limit), float64(l.config.minLimit)), float64(l.config.maxLimit)))
+}
+
+func (l *adaptiveLimiter[R]) ToExecutor(_ R) any {
+	ale := &adaptiveLimiterExecutor[R]{
+		BaseExecutor:    &policy.BaseExecutor[R]{},
+		adaptiveLimiter: l,
+	}
+	ale.Executor = ale
+	return ale
+}
@@ -0,0 +1,40 @@
+package adaptivelimiter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdjustLimit(t *testing.T) {
+	// Given
+	limiter := Builder[any]().WithLimits(1, 12).WithInitialLimit(10).Build().(*adaptiveLimiter[any])
+	adjust := func(latency time.Duration) int {
+		limiter.limit = limiter.adjustLimit(latency)
+		return limiter.limit
+	}
+
+	// When / Then
+	assert.Equal(t, 11, adjust(10*time.Millisecond), "should increase when nothing is queued")
+	assert.Equal(t, 12, adjust(11*time.Millisecond), "should increase when the queue is below the lower threshold")
+	assert.Equal(t, 12, adjust(10*time.Millisecond), "should not increase beyond the max limit")
+	assert.Equal(t, 12, adjust(16*time.Millisecond), "should not change when the queue is between thresholds")
+	assert.Equal(t, 11, adjust(50*time.Millisecond), "should decrease when the queue is above the upper threshold")
+	assert.Equal(t, 10, adjust(50*time.Millisecond))
+}
+
+func TestAdjustLimitResetsMinLatency(t *testing.T) {
+	// Given
+	limiter := Builder[any]().WithLimits(1, 1).WithInitialLimit(1).Build().(*adaptiveLimiter[any])
+
+	// When
+	limiter.adjustLimit(time.Millisecond)
+	for i := 0; i < minLatencyResetFactor; i++ {
+		limiter.adjustLimit(10 * time.Millisecond)
+	}
+
+	// Then
+	assert.Equal(t, 10*time.Millisecond, limiter.minLatency)
+	assert.Equal(t, 1, limiter.samplesCount)
+}
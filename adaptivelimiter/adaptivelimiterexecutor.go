@@ -0,0 +1,44 @@
+package adaptivelimiter
+
+import (
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/common"
+	"github.com/failsafe-go/failsafe-go/internal"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// adaptiveLimiterExecutor is a policy.Executor that handles failures according to an AdaptiveLimiter.
+type adaptiveLimiterExecutor[R any] struct {
+	*policy.BaseExecutor[R]
+	*adaptiveLimiter[R]
+}
+
+var _ policy.Executor[any] = &adaptiveLimiterExecutor[any]{}
+
+func (e *adaptiveLimiterExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.PolicyResult[R]) func(failsafe.Execution[R]) *common.PolicyResult[R] {
+	return func(exec failsafe.Execution[R]) *common.PolicyResult[R] {
+		execInternal := exec.(policy.ExecutionInternal[R])
+		if !e.tryAcquirePermit() {
+			if metrics := execInternal.Metrics(); metrics != nil {
+				metrics.IncrementCounter(failsafe.MetricRejections, map[string]string{"policy": "AdaptiveLimiter"})
+			}
+			if e.config.onLimitExceeded != nil {
+				e.config.onLimitExceeded(failsafe.ExecutionEvent[R]{
+					ExecutionAttempt: execInternal.CopyWithResult(nil),
+				})
+			}
+			return internal.FailureResult[R](ErrLimitExceeded)
+		}
+
+		startTime := time.Now()
+		result := innerFn(exec)
+		if canceled, cancelResult := execInternal.IsCanceledWithResult(); canceled {
+			e.releasePermit(-1)
+			return cancelResult
+		}
+		e.releasePermit(time.Since(startTime))
+		return result
+	}
+}
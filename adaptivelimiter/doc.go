@@ -0,0 +1,2 @@
+// Package adaptivelimiter provides an AdaptiveLimiter.
+package adaptivelimiter
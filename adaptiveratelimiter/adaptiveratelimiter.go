@@ -0,0 +1,252 @@
+package adaptiveratelimiter
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// ErrExceeded is returned when an execution exceeds the current rate of an AdaptiveRateLimiter.
+var ErrExceeded = errors.New("adaptive rate limit exceeded")
+
+/*
+AdaptiveRateLimiter is a Policy that limits executions to a rate which adapts to throttling by a downstream dependency,
+such as via HTTP 429 responses, using an additive increase, multiplicative decrease (AIMD) algorithm. Each execution
+that is not throttled additively increases the rate, up to a max rate, and each execution that is throttled
+multiplicatively decreases the rate, down to a min rate. This keeps the rate of executions just under the limit that a
+dependency enforces, without needing to know the limit in advance.
+
+Permits are evenly distributed over time, based on the current rate. When the current rate is exceeded, executions wait
+up to a max wait time for a permit, after which ErrExceeded is returned.
+
+This type is concurrency safe.
+*/
+type AdaptiveRateLimiter[R any] interface {
+	failsafe.Policy[R]
+
+	// Rate returns the current rate, in permits per second.
+	Rate() float64
+
+	// TryAcquirePermit tries to acquire a permit to perform an execution, returning whether the permit was acquired.
+	TryAcquirePermit() bool
+
+	// RecordSuccess records an execution that was not throttled, increasing the rate.
+	RecordSuccess()
+
+	// RecordThrottled records an execution that was throttled, decreasing the rate.
+	RecordThrottled()
+}
+
+/*
+AdaptiveRateLimiterBuilder builds AdaptiveRateLimiter instances.
+
+This type is not concurrency safe.
+*/
+type AdaptiveRateLimiterBuilder[R any] interface {
+	// WithInitialRate configures the rate, in permits per second, that the AdaptiveRateLimiter starts with. The default is
+	// the max rate.
+	WithInitialRate(rate float64) AdaptiveRateLimiterBuilder[R]
+
+	// WithMinRate configures the min rate, in permits per second, that the rate can decrease to. The default is 1.
+	WithMinRate(minRate float64) AdaptiveRateLimiterBuilder[R]
+
+	// WithMaxRate configures the max rate, in permits per second, that the rate can increase to. The default is 100.
+	WithMaxRate(maxRate float64) AdaptiveRateLimiterBuilder[R]
+
+	// WithIncrease configures the amount, in permits per second, that the rate is increased by for each execution that is
+	// not throttled. The default is 1.
+	WithIncrease(increase float64) AdaptiveRateLimiterBuilder[R]
+
+	// WithDecreaseFactor configures the factor that the rate is multiplied by for each execution that is throttled. The
+	// default is .5.
+	WithDecreaseFactor(decreaseFactor float64) AdaptiveRateLimiterBuilder[R]
+
+	// ThrottleIf configures the predicate that determines whether an execution result or error indicates the execution was
+	// throttled. By default, no executions are considered throttled.
+	ThrottleIf(predicate func(R, error) bool) AdaptiveRateLimiterBuilder[R]
+
+	// WithMaxWaitTime configures the maxWaitTime to wait for a permit to be available. If a permit cannot be acquired
+	// before the maxWaitTime is exceeded, then ErrExceeded is returned. The default is 0.
+	WithMaxWaitTime(maxWaitTime time.Duration) AdaptiveRateLimiterBuilder[R]
+
+	// OnRateChanged registers the listener to be called when the rate changes.
+	OnRateChanged(listener func(event RateChangedEvent)) AdaptiveRateLimiterBuilder[R]
+
+	// Build returns a new AdaptiveRateLimiter using the builder's configuration.
+	Build() AdaptiveRateLimiter[R]
+}
+
+// RateChangedEvent indicates an AdaptiveRateLimiter's rate has changed.
+type RateChangedEvent struct {
+	OldRate float64
+	NewRate float64
+}
+
+type adaptiveRateLimiterConfig[R any] struct {
+	initialRate    float64
+	minRate        float64
+	maxRate        float64
+	increase       float64
+	decreaseFactor float64
+	throttleIf     func(R, error) bool
+	maxWaitTime    time.Duration
+	onRateChanged  func(RateChangedEvent)
+}
+
+var _ AdaptiveRateLimiterBuilder[any] = &adaptiveRateLimiterConfig[any]{}
+
+type adaptiveRateLimiter[R any] struct {
+	config *adaptiveRateLimiterConfig[R]
+	mtx    sync.Mutex
+
+	// Guarded by mtx
+	rate               float64
+	nextFreePermitTime time.Time
+}
+
+// WithDefaults returns a new AdaptiveRateLimiter for execution result type R with a min rate of 1, a max rate of 100,
+// and an initial rate of 100 permits per second, which throttles when the throttlePredicate is true.
+func WithDefaults[R any](throttlePredicate func(R, error) bool) AdaptiveRateLimiter[R] {
+	return Builder[R]().ThrottleIf(throttlePredicate).Build()
+}
+
+// Builder returns an AdaptiveRateLimiterBuilder for execution result type R, which by default will build an
+// AdaptiveRateLimiter with a min rate of 1, a max rate of 100, and an initial rate of 100 permits per second, which
+// increases the rate by 1 for each execution that is not throttled, and halves the rate for each execution that is.
+func Builder[R any]() AdaptiveRateLimiterBuilder[R] {
+	return &adaptiveRateLimiterConfig[R]{
+		minRate:        1,
+		maxRate:        100,
+		increase:       1,
+		decreaseFactor: .5,
+	}
+}
+
+func (c *adaptiveRateLimiterConfig[R]) WithInitialRate(rate float64) AdaptiveRateLimiterBuilder[R] {
+	c.initialRate = rate
+	return c
+}
+
+func (c *adaptiveRateLimiterConfig[R]) WithMinRate(minRate float64) AdaptiveRateLimiterBuilder[R] {
+	c.minRate = minRate
+	return c
+}
+
+func (c *adaptiveRateLimiterConfig[R]) WithMaxRate(maxRate float64) AdaptiveRateLimiterBuilder[R] {
+	c.maxRate = maxRate
+	return c
+}
+
+func (c *adaptiveRateLimiterConfig[R]) WithIncrease(increase float64) AdaptiveRateLimiterBuilder[R] {
+	c.increase = increase
+	return c
+}
+
+func (c *adaptiveRateLimiterConfig[R]) WithDecreaseFactor(decreaseFactor float64) AdaptiveRateLimiterBuilder[R] {
+	c.decreaseFactor = decreaseFactor
+	return c
+}
+
+func (c *adaptiveRateLimiterConfig[R]) ThrottleIf(predicate func(R, error) bool) AdaptiveRateLimiterBuilder[R] {
+	c.throttleIf = predicate
+	return c
+}
+
+func (c *adaptiveRateLimiterConfig[R]) WithMaxWaitTime(maxWaitTime time.Duration) AdaptiveRateLimiterBuilder[R] {
+	c.maxWaitTime = maxWaitTime
+	return c
+}
+
+func (c *adaptiveRateLimiterConfig[R]) OnRateChanged(listener func(event RateChangedEvent)) AdaptiveRateLimiterBuilder[R] {
+	c.onRateChanged = listener
+	return c
+}
+
+func (c *adaptiveRateLimiterConfig[R]) Build() AdaptiveRateLimiter[R] {
+	arlCopy := *c
+	rate := arlCopy.initialRate
+	if rate == 0 {
+		rate = arlCopy.maxRate
+	}
+	return &adaptiveRateLimiter[R]{
+		config: &arlCopy,
+		rate:   min(max(rate, arlCopy.minRate), arlCopy.maxRate),
+	}
+}
+
+func (r *adaptiveRateLimiter[R]) Rate() float64 {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.rate
+}
+
+func (r *adaptiveRateLimiter[R]) TryAcquirePermit() bool {
+	return r.reservePermit(0) != -1
+}
+
+func (r *adaptiveRateLimiter[R]) RecordSuccess() {
+	r.adjustRate(func(rate float64) float64 {
+		return rate + r.config.increase
+	})
+}
+
+func (r *adaptiveRateLimiter[R]) RecordThrottled() {
+	r.adjustRate(func(rate float64) float64 {
+		return rate * r.config.decreaseFactor
+	})
+}
+
+// recordResult records whether the result and err indicate an execution was throttled.
+func (r *adaptiveRateLimiter[R]) recordResult(result R, err error) {
+	if r.config.throttleIf != nil && r.config.throttleIf(result, err) {
+		r.RecordThrottled()
+	} else {
+		r.RecordSuccess()
+	}
+}
+
+// adjustRate adjusts the rate using the adjustFn, bounded by the min and max rates, and calls any listener.
+func (r *adaptiveRateLimiter[R]) adjustRate(adjustFn func(rate float64) float64) {
+	r.mtx.Lock()
+	oldRate := r.rate
+	r.rate = min(max(adjustFn(oldRate), r.config.minRate), r.config.maxRate)
+	newRate := r.rate
+	r.mtx.Unlock()
+
+	if newRate != oldRate && r.config.onRateChanged != nil {
+		r.config.onRateChanged(RateChangedEvent{
+			OldRate: oldRate,
+			NewRate: newRate,
+		})
+	}
+}
+
+// reservePermit reserves a permit and returns the time that must be waited in order to use it, else returns -1 if the
+// wait time would exceed the maxWaitTime.
+func (r *adaptiveRateLimiter[R]) reservePermit(maxWaitTime time.Duration) time.Duration {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	now := time.Now()
+	if r.nextFreePermitTime.Before(now) {
+		r.nextFreePermitTime = now
+	}
+	waitTime := r.nextFreePermitTime.Sub(now)
+	if waitTime > maxWaitTime {
+		return -1
+	}
+	r.nextFreePermitTime = r.nextFreePermitTime.Add(time.Duration(float64(time.Second) / r.rate))
+	return waitTime
+}
+
+func (r *adaptiveRateLimiter[R]) ToExecutor(_ R) any {
+	are := &adaptiveRateLimiterExecutor[R]{
+		BaseExecutor:        &policy.BaseExecutor[R]{},
+		adaptiveRateLimiter: r,
+	}
+	are.Executor = are
+	return are
+}
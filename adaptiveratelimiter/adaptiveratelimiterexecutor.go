@@ -0,0 +1,50 @@
+package adaptiveratelimiter
+
+import (
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/common"
+	"github.com/failsafe-go/failsafe-go/internal"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// adaptiveRateLimiterExecutor is a policy.Executor that handles failures according to an AdaptiveRateLimiter.
+type adaptiveRateLimiterExecutor[R any] struct {
+	*policy.BaseExecutor[R]
+	*adaptiveRateLimiter[R]
+}
+
+var _ policy.Executor[any] = &adaptiveRateLimiterExecutor[any]{}
+
+func (e *adaptiveRateLimiterExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.PolicyResult[R]) func(failsafe.Execution[R]) *common.PolicyResult[R] {
+	return func(exec failsafe.Execution[R]) *common.PolicyResult[R] {
+		execInternal := exec.(policy.ExecutionInternal[R])
+		if execInternal.IsProbe() {
+			return innerFn(exec)
+		}
+
+		// Acquire a permit
+		waitTime := e.reservePermit(e.config.maxWaitTime)
+		if waitTime == -1 {
+			return internal.FailureResult[R](ErrExceeded)
+		}
+		if waitTime > 0 {
+			timer := time.NewTimer(waitTime)
+			select {
+			case <-timer.C:
+			case <-exec.Canceled():
+				timer.Stop()
+				_, cancelResult := execInternal.IsCanceledWithResult()
+				return cancelResult
+			}
+		}
+
+		result := innerFn(exec)
+		if canceled, cancelResult := execInternal.IsCanceledWithResult(); canceled {
+			return cancelResult
+		}
+		e.recordResult(result.Result, result.Error)
+		return result
+	}
+}
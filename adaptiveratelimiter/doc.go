@@ -0,0 +1,2 @@
+// Package adaptiveratelimiter provides an AdaptiveRateLimiter policy.
+package adaptiveratelimiter
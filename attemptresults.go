@@ -0,0 +1,90 @@
+package failsafe
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// AttemptResult is the outcome of an individual execution attempt. See Executor.WithAttemptResults.
+type AttemptResult[R any] struct {
+	// The attempt number, where 1 is the first attempt. Hedged attempts have their own attempt numbers.
+	Attempt int
+	// The attempt's result, else the zero value for R
+	Result R
+	// The attempt's error, else nil. If a policy terminated the attempt, this is the error that the policy produced.
+	Error error
+	// The time that the attempt started
+	StartTime time.Time
+	// The time that the attempt ended
+	EndTime time.Time
+	// The type of the policy that terminated the attempt with a failure, such as a CircuitBreaker that rejected the attempt
+	// or a Timeout that was exceeded, else "". See ExecutionDoneEvent.TerminalPolicyType.
+	TerminalPolicyType string
+}
+
+// attemptResults records the results of an execution's attempts.
+//
+// This type is concurrency safe.
+type attemptResults[R any] struct {
+	mtx sync.Mutex
+	// Guarded by mtx
+	results map[int]*AttemptResult[R]
+}
+
+func newAttemptResults[R any]() *attemptResults[R] {
+	return &attemptResults[R]{results: make(map[int]*AttemptResult[R])}
+}
+
+// recordFnResult records the result returned by the func for an attempt, unless a policy already terminated the attempt.
+func (a *attemptResults[R]) recordFnResult(attempt int, result R, err error, startTime time.Time) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	if _, ok := a.results[attempt]; ok {
+		return
+	}
+	a.results[attempt] = &AttemptResult[R]{
+		Attempt:   attempt,
+		Result:    result,
+		Error:     err,
+		StartTime: startTime,
+		EndTime:   time.Now(),
+	}
+}
+
+// recordTerminated records that a policy terminated an attempt with the err. The startTime is used if the attempt did
+// not reach the func.
+func (a *attemptResults[R]) recordTerminated(attempt int, err error, policyType string, startTime time.Time) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	result, ok := a.results[attempt]
+	if !ok {
+		result = &AttemptResult[R]{
+			Attempt:   attempt,
+			StartTime: startTime,
+		}
+		a.results[attempt] = result
+	}
+	result.Error = err
+	result.EndTime = time.Now()
+	result.TerminalPolicyType = policyType
+}
+
+// snapshot returns a copy of the attempt results, ordered by attempt. If discardResults is true, the results are replaced
+// with the zero value for R.
+func (a *attemptResults[R]) snapshot(discardResults bool) []AttemptResult[R] {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	results := make([]AttemptResult[R], 0, len(a.results))
+	for _, result := range a.results {
+		r := *result
+		if discardResults {
+			r.Result = *(new(R))
+		}
+		results = append(results, r)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Attempt < results[j].Attempt
+	})
+	return results
+}
@@ -0,0 +1,54 @@
+package failsafe_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/bulkhead"
+	"github.com/failsafe-go/failsafe-go/circuitbreaker"
+	"github.com/failsafe-go/failsafe-go/fallback"
+	"github.com/failsafe-go/failsafe-go/ratelimiter"
+	"github.com/failsafe-go/failsafe-go/retrypolicy"
+	"github.com/failsafe-go/failsafe-go/timeout"
+)
+
+// Benchmarks the overhead that policies add to an execution whose fn does no work.
+
+func BenchmarkNoPolicies(b *testing.B) {
+	benchmarkExecutor(b, failsafe.NewExecutor[bool]())
+}
+
+func BenchmarkRetryPolicy(b *testing.B) {
+	benchmarkExecutor(b, failsafe.NewExecutor[bool](retrypolicy.WithDefaults[bool]()))
+}
+
+func BenchmarkCircuitBreaker(b *testing.B) {
+	benchmarkExecutor(b, failsafe.NewExecutor[bool](circuitbreaker.WithDefaults[bool]()))
+}
+
+func BenchmarkTimeout(b *testing.B) {
+	benchmarkExecutor(b, failsafe.NewExecutor[bool](timeout.With[bool](time.Minute)))
+}
+
+func BenchmarkComposition(b *testing.B) {
+	benchmarkExecutor(b, failsafe.NewExecutor[bool](
+		fallback.WithResult(true),
+		retrypolicy.WithDefaults[bool](),
+		circuitbreaker.WithDefaults[bool](),
+		ratelimiter.BurstyBuilder[bool](math.MaxUint32, time.Second).Build(),
+		bulkhead.With[bool](math.MaxUint32),
+		timeout.With[bool](time.Minute),
+	))
+}
+
+func benchmarkExecutor(b *testing.B, executor failsafe.Executor[bool]) {
+	fn := func() (bool, error) {
+		return true, nil
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = executor.Get(fn)
+	}
+}
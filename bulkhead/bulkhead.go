@@ -1,12 +1,13 @@
 package bulkhead
 
 import (
+	"container/list"
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"time"
 
-	"golang.org/x/sync/semaphore"
-
 	"github.com/failsafe-go/failsafe-go"
 	"github.com/failsafe-go/failsafe-go/policy"
 )
@@ -14,6 +15,53 @@ import (
 // ErrFull is returned when an execution is attempted against a Bulkhead that is full.
 var ErrFull = errors.New("bulkhead full")
 
+// errQueueFull is returned when a permit cannot be waited for because the Bulkhead's queue is full.
+var errQueueFull = fmt.Errorf("%w: max queue exceeded", ErrFull)
+
+// RejectionReason describes why a Bulkhead rejected an execution.
+type RejectionReason int
+
+func (r RejectionReason) String() string {
+	switch r {
+	case RejectedFull:
+		return "full"
+	case RejectedMaxWaitExceeded:
+		return "max-wait-exceeded"
+	case RejectedCanceled:
+		return "canceled"
+	case RejectedQueueFull:
+		return "queue-full"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	// RejectedFull indicates an execution was rejected because the Bulkhead was full and no max wait time is configured.
+	RejectedFull RejectionReason = iota
+
+	// RejectedMaxWaitExceeded indicates an execution was rejected because the Bulkhead was full and the max wait time was
+	// exceeded while waiting for a permit.
+	RejectedMaxWaitExceeded
+
+	// RejectedCanceled indicates an execution was rejected because it was canceled while waiting for a permit, such as
+	// when the caller's context is done.
+	RejectedCanceled
+
+	// RejectedQueueFull indicates an execution was rejected because the Bulkhead was full and the max number of executions
+	// were already waiting for a permit.
+	RejectedQueueFull
+)
+
+// RejectedEvent indicates an execution was rejected by a Bulkhead.
+type RejectedEvent[R any] struct {
+	failsafe.ExecutionAttempt[R]
+	// The reason the execution was rejected
+	Reason RejectionReason
+	// The context of the execution that was rejected
+	Context context.Context
+}
+
 // Bulkhead is a policy restricts concurrent executions as a way of preventing system overload.
 //
 // This type is concurrency safe.
@@ -29,8 +77,9 @@ type Bulkhead[R any] interface {
 
 	// AcquirePermitWithMaxWait attempts to acquire a permit to perform an execution within the Bulkhead, waiting up to the
 	// maxWaitTime until one is available or the ctx is canceled. Returns ErrFull if a permit could not be acquired
-	// in time. Returns context.Canceled if the ctx is canceled. Callers should call ReleasePermit to release a successfully
-	// acquired permit back to the Bulkhead.
+	// in time or if the max queue is exceeded. If the maxWaitTime is 0, this returns ErrFull immediately without waiting
+	// when no permit is available. Returns context.Canceled if the ctx is canceled. Callers should call ReleasePermit to
+	// release a successfully acquired permit back to the Bulkhead.
 	//
 	// ctx may be nil.
 	AcquirePermitWithMaxWait(ctx context.Context, maxWaitTime time.Duration) error
@@ -42,6 +91,12 @@ type Bulkhead[R any] interface {
 	// waiting. Returns true if the permit was acquired, else false. Callers should call ReleasePermit to release a
 	// successfully acquired permit back to the Bulkhead.
 	TryAcquirePermit() bool
+
+	// QueueSize returns the number of executions that are currently waiting for a permit.
+	QueueSize() int
+
+	// ActivePermits returns the number of permits that are currently acquired.
+	ActivePermits() int
 }
 
 // BulkheadBuilder builds Bulkhead instances.
@@ -51,9 +106,18 @@ type BulkheadBuilder[R any] interface {
 	// WithMaxWaitTime configures the maxWaitTime to wait for permits to be available.
 	WithMaxWaitTime(maxWaitTime time.Duration) BulkheadBuilder[R]
 
-	// OnFull registers the listener to be called when the bulkhead is full.
+	// WithMaxQueue configures the max number of executions that can wait for a permit at a time. Executions beyond the
+	// maxQueue are rejected immediately, rather than waiting up to the max wait time. By default, the number of waiting
+	// executions is unbounded.
+	WithMaxQueue(maxQueue uint) BulkheadBuilder[R]
+
+	// OnFull registers the listener to be called when an execution is rejected because the bulkhead is full.
 	OnFull(listener func(event failsafe.ExecutionEvent[R])) BulkheadBuilder[R]
 
+	// OnRejected registers the listener to be called when an execution is rejected, with the reason the execution was
+	// rejected. This is useful for distinguishing a Bulkhead that is overloaded from callers that gave up while waiting.
+	OnRejected(listener func(event RejectedEvent[R])) BulkheadBuilder[R]
+
 	// Build returns a new Bulkhead using the builder's configuration.
 	Build() Bulkhead[R]
 }
@@ -61,7 +125,10 @@ type BulkheadBuilder[R any] interface {
 type bulkheadConfig[R any] struct {
 	maxConcurrency uint
 	maxWaitTime    time.Duration
-	onFull         func(failsafe.ExecutionEvent[R])
+	// -1 indicates an unbounded queue
+	maxQueue   int
+	onFull     func(failsafe.ExecutionEvent[R])
+	onRejected func(RejectedEvent[R])
 }
 
 func (c *bulkheadConfig[R]) WithMaxWaitTime(maxWaitTime time.Duration) BulkheadBuilder[R] {
@@ -69,15 +136,24 @@ func (c *bulkheadConfig[R]) WithMaxWaitTime(maxWaitTime time.Duration) BulkheadB
 	return c
 }
 
+func (c *bulkheadConfig[R]) WithMaxQueue(maxQueue uint) BulkheadBuilder[R] {
+	c.maxQueue = int(maxQueue)
+	return c
+}
+
 func (c *bulkheadConfig[R]) OnFull(listener func(event failsafe.ExecutionEvent[R])) BulkheadBuilder[R] {
 	c.onFull = listener
 	return c
 }
 
+func (c *bulkheadConfig[R]) OnRejected(listener func(event RejectedEvent[R])) BulkheadBuilder[R] {
+	c.onRejected = listener
+	return c
+}
+
 func (c *bulkheadConfig[R]) Build() Bulkhead[R] {
 	return &bulkhead[R]{
-		config:    c, // TODO copy base fields
-		semaphore: semaphore.NewWeighted(int64(c.maxConcurrency)),
+		config: c, // TODO copy base fields
 	}
 }
 
@@ -92,43 +168,135 @@ func With[R any](maxConcurrency uint) Bulkhead[R] {
 func Builder[R any](maxConcurrency uint) BulkheadBuilder[R] {
 	return &bulkheadConfig[R]{
 		maxConcurrency: maxConcurrency,
+		maxQueue:       -1,
 	}
 }
 
 type bulkhead[R any] struct {
-	config    *bulkheadConfig[R]
-	semaphore *semaphore.Weighted
+	config *bulkheadConfig[R]
+	mtx    sync.Mutex
+
+	// Guarded by mtx
+	activePermits int
+	// FIFO queue of channels that are closed when a waiter is granted a permit
+	waiters list.List
 }
 
 func (b *bulkhead[R]) AcquirePermit(ctx context.Context) error {
 	if ctx == nil {
 		ctx = context.Background()
 	}
-	if err := b.semaphore.Acquire(ctx, 1); err != nil {
+	if err := b.acquirePermit(ctx, nil); err != nil {
 		return ErrFull
 	}
 	return nil
 }
 
 func (b *bulkhead[R]) AcquirePermitWithMaxWait(ctx context.Context, maxWaitTime time.Duration) error {
+	if maxWaitTime <= 0 {
+		// Don't enqueue a waiter that would give up immediately
+		if b.TryAcquirePermit() {
+			return nil
+		}
+		return ErrFull
+	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
-	ctx, cancel := context.WithTimeout(ctx, maxWaitTime)
-	err := b.semaphore.Acquire(ctx, 1)
-	if err != nil && errors.Is(err, context.DeadlineExceeded) {
+	timer := time.NewTimer(maxWaitTime)
+	defer timer.Stop()
+	return b.acquirePermit(ctx, timer.C)
+}
+
+// acquirePermit acquires a permit, waiting until one is available, the ctx is done, or the timeout fires. Returns
+// ErrFull if the timeout fires before a permit is available, errQueueFull if the max queue is exceeded, else the ctx
+// error if the ctx is done.
+func (b *bulkhead[R]) acquirePermit(ctx context.Context, timeout <-chan time.Time) error {
+	b.mtx.Lock()
+	if b.tryAcquirePermit() {
+		b.mtx.Unlock()
+		return nil
+	}
+	if b.config.maxQueue >= 0 && b.waiters.Len() >= b.config.maxQueue {
+		b.mtx.Unlock()
+		return errQueueFull
+	}
+	ready := make(chan struct{})
+	elem := b.waiters.PushBack(ready)
+	b.mtx.Unlock()
+
+	var err error
+	select {
+	case <-ready:
+		return nil
+	case <-timeout:
 		err = ErrFull
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	select {
+	case <-ready:
+		// A permit was granted while giving up, so use it
+		return nil
+	default:
+		b.waiters.Remove(elem)
+		return err
 	}
-	cancel()
-	return err
 }
 
 func (b *bulkhead[R]) TryAcquirePermit() bool {
-	return b.semaphore.TryAcquire(1)
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.tryAcquirePermit()
+}
+
+// Requires external locking.
+func (b *bulkhead[R]) tryAcquirePermit() bool {
+	if b.activePermits < int(b.config.maxConcurrency) && b.waiters.Len() == 0 {
+		b.activePermits++
+		return true
+	}
+	return false
 }
 
 func (b *bulkhead[R]) ReleasePermit() {
-	b.semaphore.Release(1)
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if b.activePermits > 0 {
+		b.activePermits--
+	}
+
+	// Hand permits to waiters in FIFO order
+	for b.activePermits < int(b.config.maxConcurrency) && b.waiters.Len() > 0 {
+		front := b.waiters.Front()
+		b.waiters.Remove(front)
+		b.activePermits++
+		close(front.Value.(chan struct{}))
+	}
+}
+
+func (b *bulkhead[R]) QueueSize() int {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.waiters.Len()
+}
+
+func (b *bulkhead[R]) ActivePermits() int {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.activePermits
+}
+
+// DescribeConfig describes the Bulkhead's configuration for failsafe.Executor.ConfigJSON.
+func (b *bulkhead[R]) DescribeConfig() map[string]any {
+	return map[string]any{
+		"maxConcurrency": b.config.maxConcurrency,
+		"maxWaitTime":    b.config.maxWaitTime.String(),
+		"maxQueue":       b.config.maxQueue,
+	}
 }
 
 func (b *bulkhead[R]) ToExecutor(_ R) any {
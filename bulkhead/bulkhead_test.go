@@ -1,6 +1,7 @@
 package bulkhead
 
 import (
+	"sync"
 	"testing"
 	"time"
 
@@ -51,3 +52,84 @@ func TestTryAcquirePermitAndReleasePermit(t *testing.T) {
 	assert.True(t, bulkhead.TryAcquirePermit())
 	assert.False(t, bulkhead.TryAcquirePermit())
 }
+
+func TestQueueSizeAndActivePermits(t *testing.T) {
+	bulkhead := With[any](2)
+	assert.True(t, bulkhead.TryAcquirePermit())
+	assert.True(t, bulkhead.TryAcquirePermit())
+	assert.Equal(t, 2, bulkhead.ActivePermits())
+	assert.Equal(t, 0, bulkhead.QueueSize())
+
+	// Queue 3 waiters
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, bulkhead.AcquirePermit(nil))
+		}()
+	}
+	assert.Eventually(t, func() bool {
+		return bulkhead.QueueSize() == 3
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 2, bulkhead.ActivePermits())
+
+	// Releasing a permit should hand it to a waiter
+	bulkhead.ReleasePermit()
+	assert.Eventually(t, func() bool {
+		return bulkhead.QueueSize() == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 2, bulkhead.ActivePermits())
+
+	bulkhead.ReleasePermit()
+	bulkhead.ReleasePermit()
+	wg.Wait()
+	assert.Equal(t, 0, bulkhead.QueueSize())
+	assert.Equal(t, 2, bulkhead.ActivePermits())
+
+	bulkhead.ReleasePermit()
+	bulkhead.ReleasePermit()
+	assert.Equal(t, 0, bulkhead.ActivePermits())
+}
+
+// Asserts that waiters that give up are removed from the queue.
+func TestQueueSizeAfterMaxWaitTimeExceeded(t *testing.T) {
+	bulkhead := With[any](1)
+	assert.True(t, bulkhead.TryAcquirePermit())
+
+	err := bulkhead.AcquirePermitWithMaxWait(nil, 50*time.Millisecond)
+	assert.ErrorIs(t, err, ErrFull)
+	assert.Equal(t, 0, bulkhead.QueueSize())
+	assert.Equal(t, 1, bulkhead.ActivePermits())
+}
+
+// Asserts that waiters beyond the max queue are rejected immediately.
+func TestAcquirePermitWithMaxQueue(t *testing.T) {
+	bulkhead := Builder[any](1).WithMaxQueue(1).Build()
+	assert.True(t, bulkhead.TryAcquirePermit())
+	go bulkhead.AcquirePermitWithMaxWait(nil, time.Second)
+	assert.Eventually(t, func() bool {
+		return bulkhead.QueueSize() == 1
+	}, time.Second, time.Millisecond)
+
+	elapsed := testutil.Timed(func() {
+		err := bulkhead.AcquirePermitWithMaxWait(nil, time.Second)
+		assert.ErrorIs(t, err, ErrFull)
+	})
+	assert.True(t, elapsed < 100*time.Millisecond)
+	assert.Equal(t, 1, bulkhead.QueueSize())
+}
+
+// Asserts that a zero max wait time is rejected as full without being queued or counted against the max queue.
+func TestAcquirePermitWithZeroMaxWaitTime(t *testing.T) {
+	bulkhead := Builder[any](1).WithMaxQueue(0).Build()
+	assert.Nil(t, bulkhead.AcquirePermitWithMaxWait(nil, 0))
+
+	err := bulkhead.AcquirePermitWithMaxWait(nil, 0)
+	assert.Equal(t, ErrFull, err)
+	assert.Equal(t, 0, bulkhead.QueueSize())
+	assert.Equal(t, 1, bulkhead.ActivePermits())
+
+	bulkhead.ReleasePermit()
+	assert.True(t, bulkhead.TryAcquirePermit())
+}
@@ -1,6 +1,8 @@
 package bulkhead
 
 import (
+	"errors"
+
 	"github.com/failsafe-go/failsafe-go"
 	"github.com/failsafe-go/failsafe-go/common"
 	"github.com/failsafe-go/failsafe-go/internal"
@@ -19,13 +21,37 @@ func (e *bulkheadExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.
 	return func(exec failsafe.Execution[R]) *common.PolicyResult[R] {
 		execInternal := exec.(policy.ExecutionInternal[R])
 		if err := e.AcquirePermitWithMaxWait(execInternal.Context(), e.config.maxWaitTime); err != nil {
+			if metrics := execInternal.Metrics(); metrics != nil {
+				metrics.IncrementCounter(failsafe.MetricRejections, map[string]string{"policy": "Bulkhead"})
+			}
 			if e.config.onFull != nil {
 				e.config.onFull(failsafe.ExecutionEvent[R]{
 					ExecutionAttempt: execInternal,
 				})
 			}
+			if e.config.onRejected != nil {
+				e.config.onRejected(RejectedEvent[R]{
+					ExecutionAttempt: execInternal,
+					Reason:           e.rejectionReason(err),
+					Context:          execInternal.Context(),
+				})
+			}
 			return internal.FailureResult[R](err)
 		}
 		return innerFn(exec)
 	}
 }
+
+// rejectionReason returns the reason that an execution was rejected with the err.
+func (e *bulkheadExecutor[R]) rejectionReason(err error) RejectionReason {
+	if err == errQueueFull {
+		return RejectedQueueFull
+	}
+	if !errors.Is(err, ErrFull) {
+		return RejectedCanceled
+	}
+	if e.config.maxWaitTime == 0 {
+		return RejectedFull
+	}
+	return RejectedMaxWaitExceeded
+}
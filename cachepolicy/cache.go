@@ -0,0 +1,182 @@
+package cachepolicy
+
+import (
+	"context"
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// Cache is a cache that a CachePolicy stores and retrieves execution results with. Implementations can wrap any cache,
+// such as an in-memory map, Ristretto, or Redis.
+//
+// Implementations must be concurrency safe.
+type Cache[R any] interface {
+	// Get returns the result cached for the key, and whether it was found.
+	Get(key string) (R, bool)
+
+	// Set caches the result for the key. If ttl is greater than 0, the result should expire after the ttl, else it should
+	// not expire.
+	Set(key string, result R, ttl time.Duration)
+}
+
+/*
+CachePolicy is a policy that returns a cached result, if one exists for an execution's cache key, rather than executing
+the policies and func that are composed inside of it. If no cached result exists, the execution is performed, and
+successful results are cached for subsequent executions with the same key.
+
+The cache key for an execution is determined by the first of the following that's available:
+
+  - A key stored in the execution's context via ContextWithCacheKey
+  - The key returned by a key func configured via CachePolicyBuilder.WithKeyFunc
+  - The key configured via CachePolicyBuilder.WithKey
+
+If no cache key is available, or the key is "", the execution is performed without using the cache.
+
+This type is concurrency safe.
+*/
+type CachePolicy[R any] interface {
+	failsafe.Policy[R]
+}
+
+/*
+CachePolicyBuilder builds CachePolicy instances.
+
+This type is not concurrency safe.
+*/
+type CachePolicyBuilder[R any] interface {
+	// WithKey configures the key to cache results with, when a key is not provided via the execution's context or a key
+	// func.
+	WithKey(key string) CachePolicyBuilder[R]
+
+	// WithKeyFunc configures the keyFunc to compute the key to cache results with for each execution, when a key is not
+	// provided via the execution's context. If the keyFunc returns "", the execution is performed without using the cache.
+	WithKeyFunc(keyFunc func(exec failsafe.Execution[R]) string) CachePolicyBuilder[R]
+
+	// WithTTL configures the ttl that results are cached for, which is passed to Cache.Set. By default, results are cached
+	// without a ttl.
+	WithTTL(ttl time.Duration) CachePolicyBuilder[R]
+
+	// CacheIf specifies that a result should only be cached if the predicate matches the result or error. By default,
+	// results are cached when the error is nil.
+	CacheIf(predicate func(R, error) bool) CachePolicyBuilder[R]
+
+	// OnCacheHit registers the listener to be called when a result is returned from the cache. The provided event will
+	// contain the cached result.
+	OnCacheHit(listener func(event failsafe.ExecutionDoneEvent[R])) CachePolicyBuilder[R]
+
+	// OnCacheMiss registers the listener to be called when a result is not found in the cache.
+	OnCacheMiss(listener func(event failsafe.ExecutionEvent[R])) CachePolicyBuilder[R]
+
+	// OnResultCached registers the listener to be called when a result is stored in the cache. The provided event will
+	// contain the cached result.
+	OnResultCached(listener func(event failsafe.ExecutionDoneEvent[R])) CachePolicyBuilder[R]
+
+	// Build returns a new CachePolicy using the builder's configuration.
+	Build() CachePolicy[R]
+}
+
+type cachePolicyConfig[R any] struct {
+	cache          Cache[R]
+	key            string
+	keyFunc        func(failsafe.Execution[R]) string
+	ttl            time.Duration
+	cacheIf        func(R, error) bool
+	onCacheHit     func(failsafe.ExecutionDoneEvent[R])
+	onCacheMiss    func(failsafe.ExecutionEvent[R])
+	onResultCached func(failsafe.ExecutionDoneEvent[R])
+}
+
+var _ CachePolicyBuilder[any] = &cachePolicyConfig[any]{}
+
+type cachePolicy[R any] struct {
+	config *cachePolicyConfig[R]
+}
+
+// With returns a new CachePolicy for execution result type R that caches results with the cache. Cache keys must be
+// provided via ContextWithCacheKey. To configure additional options, such as a key func or ttl, use Builder instead.
+func With[R any](cache Cache[R]) CachePolicy[R] {
+	return Builder[R](cache).Build()
+}
+
+// Builder returns a new CachePolicyBuilder for execution result type R which builds CachePolicies that cache results
+// with the cache.
+func Builder[R any](cache Cache[R]) CachePolicyBuilder[R] {
+	return &cachePolicyConfig[R]{
+		cache: cache,
+		cacheIf: func(_ R, err error) bool {
+			return err == nil
+		},
+	}
+}
+
+func (c *cachePolicyConfig[R]) WithKey(key string) CachePolicyBuilder[R] {
+	c.key = key
+	return c
+}
+
+func (c *cachePolicyConfig[R]) WithKeyFunc(keyFunc func(exec failsafe.Execution[R]) string) CachePolicyBuilder[R] {
+	c.keyFunc = keyFunc
+	return c
+}
+
+func (c *cachePolicyConfig[R]) WithTTL(ttl time.Duration) CachePolicyBuilder[R] {
+	c.ttl = ttl
+	return c
+}
+
+func (c *cachePolicyConfig[R]) CacheIf(predicate func(R, error) bool) CachePolicyBuilder[R] {
+	c.cacheIf = predicate
+	return c
+}
+
+func (c *cachePolicyConfig[R]) OnCacheHit(listener func(event failsafe.ExecutionDoneEvent[R])) CachePolicyBuilder[R] {
+	c.onCacheHit = listener
+	return c
+}
+
+func (c *cachePolicyConfig[R]) OnCacheMiss(listener func(event failsafe.ExecutionEvent[R])) CachePolicyBuilder[R] {
+	c.onCacheMiss = listener
+	return c
+}
+
+func (c *cachePolicyConfig[R]) OnResultCached(listener func(event failsafe.ExecutionDoneEvent[R])) CachePolicyBuilder[R] {
+	c.onResultCached = listener
+	return c
+}
+
+func (c *cachePolicyConfig[R]) Build() CachePolicy[R] {
+	cCopy := *c
+	return &cachePolicy[R]{
+		config: &cCopy,
+	}
+}
+
+func (c *cachePolicy[R]) ToExecutor(_ R) any {
+	ce := &cacheExecutor[R]{
+		BaseExecutor: &policy.BaseExecutor[R]{},
+		cachePolicy:  c,
+	}
+	ce.Executor = ce
+	return ce
+}
+
+type cacheKeyContextKey struct{}
+
+// ContextWithCacheKey returns a copy of the ctx that stores the key for a CachePolicy to cache results with, which
+// takes precedence over any key or key func that the CachePolicy is configured with.
+func ContextWithCacheKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, cacheKeyContextKey{}, key)
+}
+
+// cacheKey returns the key to cache results for the execution with, else "" if there is none.
+func (c *cachePolicy[R]) cacheKey(exec failsafe.Execution[R]) string {
+	if key, ok := exec.Context().Value(cacheKeyContextKey{}).(string); ok {
+		return key
+	}
+	if c.config.keyFunc != nil {
+		return c.config.keyFunc(exec)
+	}
+	return c.config.key
+}
@@ -0,0 +1,60 @@
+package cachepolicy
+
+import (
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/common"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// cacheExecutor is a policy.Executor that handles executions according to a CachePolicy.
+type cacheExecutor[R any] struct {
+	*policy.BaseExecutor[R]
+	*cachePolicy[R]
+}
+
+var _ policy.Executor[any] = &cacheExecutor[any]{}
+
+func (e *cacheExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.PolicyResult[R]) func(failsafe.Execution[R]) *common.PolicyResult[R] {
+	return func(exec failsafe.Execution[R]) *common.PolicyResult[R] {
+		execInternal := exec.(policy.ExecutionInternal[R])
+		key := e.cacheKey(exec)
+		if key == "" {
+			return innerFn(exec)
+		}
+
+		// Return a cached result, if any
+		if cachedResult, found := e.config.cache.Get(key); found {
+			if e.config.onCacheHit != nil {
+				e.config.onCacheHit(failsafe.ExecutionDoneEvent[R]{
+					ExecutionStats: execInternal,
+					Result:         cachedResult,
+				})
+			}
+			return &common.PolicyResult[R]{
+				Result:     cachedResult,
+				Done:       true,
+				Success:    true,
+				SuccessAll: true,
+			}
+		}
+		if e.config.onCacheMiss != nil {
+			e.config.onCacheMiss(failsafe.ExecutionEvent[R]{
+				ExecutionAttempt: execInternal.CopyWithResult(nil),
+			})
+		}
+
+		// Perform the execution and cache the result
+		result := innerFn(exec)
+		if canceled, _ := execInternal.IsCanceledWithResult(); !canceled && e.config.cacheIf(result.Result, result.Error) {
+			e.config.cache.Set(key, result.Result, e.config.ttl)
+			if e.config.onResultCached != nil {
+				e.config.onResultCached(failsafe.ExecutionDoneEvent[R]{
+					ExecutionStats: execInternal,
+					Result:         result.Result,
+					Error:          result.Error,
+				})
+			}
+		}
+		return result
+	}
+}
@@ -0,0 +1,2 @@
+// Package cachepolicy provides a CachePolicy.
+package cachepolicy
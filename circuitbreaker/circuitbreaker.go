@@ -1,6 +1,7 @@
 package circuitbreaker
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"time"
@@ -144,13 +145,29 @@ type Metrics interface {
 type StateChangedEvent struct {
 	OldState State
 	NewState State
+	// The most recent failures that were recorded before the CircuitBreaker opened, from oldest to newest, if the NewState
+	// is OpenState and recent failures are configured via CircuitBreakerBuilder.WithRecentFailures, else nil.
+	RecentFailures []RecentFailure
+
+	ctx context.Context
+}
+
+// Context returns the context of the execution that caused the state change, such as an execution whose failure opened
+// the CircuitBreaker, else context.Background if the state change was not caused by an execution. This can be used by
+// metrics integrations to associate state changes with the context of an execution, such as via a trace ID.
+func (e StateChangedEvent) Context() context.Context {
+	if e.ctx == nil {
+		return context.Background()
+	}
+	return e.ctx
 }
 
 type circuitBreaker[R any] struct {
 	config *circuitBreakerConfig[R]
 	mtx    sync.Mutex
 	// Guarded by mtx
-	state circuitState[R]
+	state          circuitState[R]
+	recentFailures *recentFailures // Nil if recent failures are not configured
 }
 
 func (cb *circuitBreaker[R]) TryAcquirePermit() bool {
@@ -174,7 +191,7 @@ func (cb *circuitBreaker[R]) HalfOpen() {
 func (cb *circuitBreaker[R]) Close() {
 	cb.mtx.Lock()
 	defer cb.mtx.Unlock()
-	cb.close()
+	cb.close(nil)
 }
 
 func (cb *circuitBreaker[R]) State() State {
@@ -258,7 +275,25 @@ func (cb *circuitBreaker[R]) RecordResult(result R) {
 func (cb *circuitBreaker[R]) RecordSuccess() {
 	cb.mtx.Lock()
 	defer cb.mtx.Unlock()
-	cb.recordSuccess()
+	cb.recordSuccess(nil)
+}
+
+// DescribeConfig describes the CircuitBreaker's configuration for failsafe.Executor.ConfigJSON.
+func (cb *circuitBreaker[R]) DescribeConfig() map[string]any {
+	return map[string]any{
+		"name":                        cb.config.name,
+		"delay":                       cb.config.Delay.String(),
+		"failureThreshold":            cb.config.failureThreshold,
+		"failureRateThreshold":        cb.config.failureRateThreshold,
+		"failureThresholdingCapacity": cb.config.failureThresholdingCapacity,
+		"failureExecutionThreshold":   cb.config.failureExecutionThreshold,
+		"failureThresholdingPeriod":   cb.config.failureThresholdingPeriod.String(),
+		"successThreshold":            cb.config.successThreshold,
+		"successThresholdingCapacity": cb.config.successThresholdingCapacity,
+		"slowCallDuration":            cb.config.slowCallDuration.String(),
+		"slowCallRateThreshold":       cb.config.slowCallRateThreshold,
+		"initialState":                cb.config.initialState.String(),
+	}
 }
 
 func (cb *circuitBreaker[R]) ToExecutor(_ R) any {
@@ -299,6 +334,26 @@ func (cb *circuitBreaker[R]) transitionTo(newState State, exec failsafe.Executio
 			OldState: currentState,
 			NewState: newState,
 		}
+		if cb.recentFailures != nil && newState != HalfOpenState {
+			// Provide the failures that opened the breaker and start over
+			if newState == OpenState {
+				event.RecentFailures = cb.recentFailures.snapshot()
+			}
+			cb.recentFailures.reset()
+		}
+		if exec != nil {
+			event.ctx = exec.Context()
+			if execInternal, ok := exec.(policy.ExecutionInternal[R]); ok {
+				if metrics := execInternal.Metrics(); metrics != nil {
+					metrics.IncrementCounter(failsafe.MetricCircuitBreakerTransitions, map[string]string{"state": newState.String()})
+					metrics.SetGauge(failsafe.MetricCircuitBreakerState, float64(newState), nil)
+				}
+				if logger := execInternal.Logger(); logger != nil {
+					logger.Debug("circuit breaker state changed", "policy", "CircuitBreaker", "attempt", exec.Attempts(),
+						"oldState", currentState, "newState", newState)
+				}
+			}
+		}
 		if cb.config.stateChangedListener != nil {
 			cb.config.stateChangedListener(event)
 		}
@@ -321,9 +376,11 @@ func (cb *circuitBreaker[R]) open(execution failsafe.Execution[R]) {
 	cb.transitionTo(OpenState, execution, cb.config.openListener)
 }
 
+// Closes the circuit breaker, considering the execution that caused it to close, if any.
+//
 // Requires external locking.
-func (cb *circuitBreaker[R]) close() {
-	cb.transitionTo(ClosedState, nil, cb.config.closeListener)
+func (cb *circuitBreaker[R]) close(execution failsafe.Execution[R]) {
+	cb.transitionTo(ClosedState, execution, cb.config.closeListener)
 }
 
 // Requires external locking.
@@ -334,25 +391,55 @@ func (cb *circuitBreaker[R]) halfOpen() {
 // Requires external locking.
 func (cb *circuitBreaker[R]) recordResult(result R, err error) {
 	if cb.config.IsFailure(result, err) {
+		cb.recordRecentFailure(result, err)
 		cb.recordFailure(nil)
 	} else {
-		cb.recordSuccess()
+		cb.recordSuccess(nil)
 	}
 }
 
 // Requires external locking.
-func (cb *circuitBreaker[R]) recordSuccess() {
+func (cb *circuitBreaker[R]) recordSuccess(exec failsafe.Execution[R]) {
+	cb.recordLatency(exec)
 	cb.state.getStats().recordSuccess()
-	cb.state.checkThresholdAndReleasePermit(nil)
+	cb.state.checkThresholdAndReleasePermit(exec)
 }
 
 // Requires external locking.
 func (cb *circuitBreaker[R]) recordFailure(exec failsafe.Execution[R]) {
+	if exec != nil {
+		cb.recordRecentFailure(exec.LastResult(), exec.LastError())
+	}
+	cb.recordLatency(exec)
 	cb.state.getStats().recordFailure()
 	cb.state.checkThresholdAndReleasePermit(exec)
 }
 
+// Records a summary of the failure, if recent failures are configured.
+//
+// Requires external locking.
+func (cb *circuitBreaker[R]) recordRecentFailure(result R, err error) {
+	if cb.recentFailures != nil {
+		cb.recentFailures.record(RecentFailure{
+			Summary: cb.config.failureSummarizer(result, err),
+			Time:    time.Unix(0, cb.config.clock.CurrentUnixNano()),
+		})
+	}
+}
+
+// Records the latency of the execution when closed, if a health func is configured.
+//
+// Requires external locking.
+func (cb *circuitBreaker[R]) recordLatency(exec failsafe.Execution[R]) {
+	if closed, ok := cb.state.(*closedState[R]); ok {
+		closed.recordLatency(exec)
+	}
+}
+
 func (cb *circuitBreaker[R]) Reset() {
-	cb.close()
+	cb.close(nil)
 	cb.state.getStats().reset()
+	if closed, ok := cb.state.(*closedState[R]); ok && closed.latencies != nil {
+		closed.latencies.reset()
+	}
 }
@@ -1,9 +1,11 @@
 package circuitbreaker
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/clock"
 	"github.com/failsafe-go/failsafe-go/internal/util"
 	"github.com/failsafe-go/failsafe-go/policy"
 )
@@ -68,7 +70,8 @@ type CircuitBreakerBuilder[R any] interface {
 
 	// WithSuccessThreshold configures count based success thresholding by setting the number of consecutive successful
 	// executions that must occur when in a HalfOpenState in order to close the circuit, else the circuit is re-opened when a
-	// failure occurs.
+	// failure occurs. When a success threshold is configured, it's used independently of any failure threshold, which then
+	// only applies in a ClosedState.
 	WithSuccessThreshold(successThreshold uint) CircuitBreakerBuilder[R]
 
 	// WithSuccessThresholdRatio configures count based success thresholding by setting the ratio of successful executions
@@ -76,6 +79,50 @@ type CircuitBreakerBuilder[R any] interface {
 	// out of the last 10 executions were successful.
 	WithSuccessThresholdRatio(successThreshold uint, successThresholdingCapacity uint) CircuitBreakerBuilder[R]
 
+	// WithInitialState configures the State that the CircuitBreaker starts in, which is ClosedState by default. Starting in
+	// an OpenState is useful for cold-start protection, such as after deploying, when a backend may not be ready to handle
+	// a full load of executions. An initially open CircuitBreaker rejects executions until its delay elapses, after which
+	// it transitions to a HalfOpenState and permits trial executions, and closes once they succeed. Starting in a
+	// HalfOpenState permits trial executions immediately. State changed listeners are not called for the initial state.
+	WithInitialState(state State) CircuitBreakerBuilder[R]
+
+	// WithHealthFunc configures the CircuitBreaker to open, when in a ClosedState, if the healthFunc returns false for the
+	// WindowStats of the current thresholding window, which include failure counts and rates, throughput, and latency
+	// percentiles. This allows arbitrary open conditions to be defined, such as opening when either the failure rate or
+	// the p99 latency is too high. The healthFunc is called each time a result is recorded in a ClosedState.
+	//
+	// The healthFunc replaces any failure threshold when in a ClosedState, but the window is still configured by the
+	// failure thresholding options. For example, WithFailureThresholdRatio(1, 100) uses a window of the last 100
+	// executions, and WithFailureRateThreshold(1, 20, time.Minute) uses a window of the last minute that must contain at
+	// least 20 executions before the healthFunc is called. Failure thresholds are still used when in a HalfOpenState if no
+	// success threshold is configured. Latencies are retained for each execution in the window, which adds some overhead.
+	WithHealthFunc(healthFunc func(stats WindowStats) bool) CircuitBreakerBuilder[R]
+
+	// WithSlowCallThreshold configures the CircuitBreaker to open, when in a ClosedState, if the percentage rate of
+	// executions, from 1 to 100, that took longer than the slowCallDuration meets or exceeds the slowCallRateThreshold.
+	// This allows latency degradation to open the circuit before hard failures occur. Slow calls are tracked over the same
+	// window as failures, which is configured by the failure thresholding options, and are tracked separately from
+	// failures, so that the circuit opens if either the failure threshold or the slow call threshold is exceeded. For
+	// time based windows, the number of executions must also exceed the failureExecutionThreshold before the circuit will
+	// be opened due to slow calls. Latencies are retained for each execution in the window, which adds some overhead.
+	WithSlowCallThreshold(slowCallDuration time.Duration, slowCallRateThreshold uint) CircuitBreakerBuilder[R]
+
+	// WithClock configures the clock that the CircuitBreaker measures delays and time-based thresholding periods with. This
+	// is useful for testing state transitions without real delays, via a clock.FakeClock. The default is clock.New().
+	WithClock(clock clock.Clock) CircuitBreakerBuilder[R]
+
+	// WithRecentFailures configures the CircuitBreaker to retain summaries of up to the last size failures that were
+	// recorded, which are provided via StateChangedEvent.RecentFailures when the CircuitBreaker opens. This is useful for
+	// seeing what was failing when a CircuitBreaker opened. The summarizer creates a summary of each failure's result or
+	// error, so that large results or errors are not retained. If the summarizer is nil, the error's message is used,
+	// else the result is formatted with fmt.Sprint. Recent failures are cleared when the CircuitBreaker opens or closes.
+	WithRecentFailures(size uint, summarizer func(R, error) string) CircuitBreakerBuilder[R]
+
+	// WithName configures a name for the CircuitBreaker and registers it, when built, in a package-level registry so that
+	// its state can be observed via OpenBreakers, OpenCount, and States. Building another CircuitBreaker with the same name
+	// replaces the previously registered one. Use Unregister to remove a CircuitBreaker from the registry.
+	WithName(name string) CircuitBreakerBuilder[R]
+
 	// Build returns a new CircuitBreaker using the builder's configuration.
 	Build() CircuitBreaker[R]
 }
@@ -83,11 +130,18 @@ type CircuitBreakerBuilder[R any] interface {
 type circuitBreakerConfig[R any] struct {
 	*policy.BaseFailurePolicy[R]
 	*policy.BaseDelayablePolicy[R]
-	clock                util.Clock
-	stateChangedListener func(StateChangedEvent)
-	openListener         func(StateChangedEvent)
-	halfOpenListener     func(StateChangedEvent)
-	closeListener        func(StateChangedEvent)
+	clock                 util.Clock
+	stateChangedListener  func(StateChangedEvent)
+	openListener          func(StateChangedEvent)
+	halfOpenListener      func(StateChangedEvent)
+	closeListener         func(StateChangedEvent)
+	name                  string
+	recentFailuresSize    uint
+	failureSummarizer     func(R, error) string
+	healthFunc            func(WindowStats) bool
+	slowCallDuration      time.Duration
+	slowCallRateThreshold uint
+	initialState          State
 
 	// Failure config
 	failureThreshold            uint
@@ -130,6 +184,18 @@ func (c *circuitBreakerConfig[R]) Build() CircuitBreaker[R] {
 		config: c, // TODO copy base fields
 	}
 	breaker.state = newClosedState[R](breaker)
+	switch c.initialState {
+	case OpenState:
+		breaker.state = newOpenState(breaker, breaker.state, c.Delay)
+	case HalfOpenState:
+		breaker.state = newHalfOpenState(breaker)
+	}
+	if c.recentFailuresSize > 0 {
+		breaker.recentFailures = newRecentFailures(int(c.recentFailuresSize))
+	}
+	if c.name != "" {
+		register(c.name, breaker)
+	}
 	return breaker
 }
 
@@ -177,6 +243,46 @@ func (c *circuitBreakerConfig[R]) WithSuccessThreshold(successThreshold uint) Ci
 	return c.WithSuccessThresholdRatio(successThreshold, successThreshold)
 }
 
+func (c *circuitBreakerConfig[R]) WithName(name string) CircuitBreakerBuilder[R] {
+	c.name = name
+	return c
+}
+
+func (c *circuitBreakerConfig[R]) WithInitialState(state State) CircuitBreakerBuilder[R] {
+	c.initialState = state
+	return c
+}
+
+func (c *circuitBreakerConfig[R]) WithHealthFunc(healthFunc func(stats WindowStats) bool) CircuitBreakerBuilder[R] {
+	c.healthFunc = healthFunc
+	return c
+}
+
+func (c *circuitBreakerConfig[R]) WithSlowCallThreshold(slowCallDuration time.Duration, slowCallRateThreshold uint) CircuitBreakerBuilder[R] {
+	c.slowCallDuration = slowCallDuration
+	c.slowCallRateThreshold = slowCallRateThreshold
+	return c
+}
+
+func (c *circuitBreakerConfig[R]) WithClock(clock clock.Clock) CircuitBreakerBuilder[R] {
+	c.clock = util.NewClockOf(clock)
+	return c
+}
+
+func (c *circuitBreakerConfig[R]) WithRecentFailures(size uint, summarizer func(R, error) string) CircuitBreakerBuilder[R] {
+	c.recentFailuresSize = size
+	c.failureSummarizer = summarizer
+	if summarizer == nil {
+		c.failureSummarizer = func(result R, err error) string {
+			if err != nil {
+				return err.Error()
+			}
+			return fmt.Sprint(result)
+		}
+	}
+	return c
+}
+
 func (c *circuitBreakerConfig[R]) WithSuccessThresholdRatio(successThreshold uint, successThresholdingCapacity uint) CircuitBreakerBuilder[R] {
 	c.successThreshold = successThreshold
 	c.successThresholdingCapacity = successThresholdingCapacity
@@ -1,6 +1,7 @@
 package circuitbreaker
 
 import (
+	"github.com/failsafe-go/failsafe-go"
 	"github.com/failsafe-go/failsafe-go/common"
 	"github.com/failsafe-go/failsafe-go/internal"
 	"github.com/failsafe-go/failsafe-go/policy"
@@ -14,8 +15,11 @@ type circuitBreakerExecutor[R any] struct {
 
 var _ policy.Executor[any] = &circuitBreakerExecutor[any]{}
 
-func (e *circuitBreakerExecutor[R]) PreExecute(_ policy.ExecutionInternal[R]) *common.PolicyResult[R] {
-	if !e.TryAcquirePermit() {
+func (e *circuitBreakerExecutor[R]) PreExecute(exec policy.ExecutionInternal[R]) *common.PolicyResult[R] {
+	if !exec.IsProbe() && !e.TryAcquirePermit() {
+		if metrics := exec.Metrics(); metrics != nil {
+			metrics.IncrementCounter(failsafe.MetricRejections, map[string]string{"policy": "CircuitBreaker"})
+		}
 		return internal.FailureResult[R](ErrOpen)
 	}
 	return nil
@@ -23,13 +27,21 @@ func (e *circuitBreakerExecutor[R]) PreExecute(_ policy.ExecutionInternal[R]) *c
 
 func (e *circuitBreakerExecutor[R]) OnSuccess(exec policy.ExecutionInternal[R], result *common.PolicyResult[R]) {
 	e.BaseExecutor.OnSuccess(exec, result)
-	e.RecordSuccess()
+	if exec.IsProbe() {
+		return
+	}
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	e.recordSuccess(exec)
 }
 
 func (e *circuitBreakerExecutor[R]) OnFailure(exec policy.ExecutionInternal[R], result *common.PolicyResult[R]) *common.PolicyResult[R] {
 	// Wrap the result in the execution so it's available when computing a delay
 	exec = exec.CopyWithResult(result).(policy.ExecutionInternal[R])
 	e.BaseExecutor.OnFailure(exec, result)
+	if exec.IsProbe() {
+		return result
+	}
 	e.mtx.Lock()
 	defer e.mtx.Unlock()
 	e.recordFailure(exec)
@@ -16,8 +16,9 @@ type circuitState[R any] interface {
 }
 
 type closedState[R any] struct {
-	breaker *circuitBreaker[R]
-	stats   circuitStats
+	breaker   *circuitBreaker[R]
+	stats     circuitStats
+	latencies *latencyWindow // Nil if a health func and slow call threshold are not configured
 }
 
 func newClosedState[R any](breaker *circuitBreaker[R]) *closedState[R] {
@@ -27,10 +28,14 @@ func newClosedState[R any](breaker *circuitBreaker[R]) *closedState[R] {
 	} else {
 		capacity = breaker.config.failureThresholdingCapacity
 	}
-	return &closedState[R]{
+	state := &closedState[R]{
 		breaker: breaker,
 		stats:   newStats(breaker.config, true, capacity),
 	}
+	if breaker.config.healthFunc != nil || breaker.config.slowCallRateThreshold != 0 {
+		state.latencies = newLatencyWindow(breaker.config, capacity)
+	}
+	return state
 }
 
 func (s *closedState[R]) getState() State {
@@ -49,19 +54,52 @@ func (s *closedState[R]) tryAcquirePermit() bool {
 	return true
 }
 
-// Checks to see if the executions and failure thresholds have been exceeded, opening the circuit if so.
+// Checks to see if the executions and failure or slow call thresholds have been exceeded, or if the health func considers
+// the window unhealthy, opening the circuit if so.
 func (s *closedState[R]) checkThresholdAndReleasePermit(exec failsafe.Execution[R]) {
 	// Execution threshold can only be set for time based thresholding
 	if s.stats.getExecutionCount() >= s.breaker.config.failureExecutionThreshold {
+		if healthFunc := s.breaker.config.healthFunc; healthFunc != nil {
+			if !healthFunc(s.windowStats()) {
+				s.breaker.open(exec)
+			}
+			return
+		}
+
 		// Failure rate threshold can only be set for time based thresholding
 		failureRateThreshold := s.breaker.config.failureRateThreshold
 		if (failureRateThreshold != 0 && s.stats.getFailureRate() >= failureRateThreshold) ||
 			(failureRateThreshold == 0 && s.stats.getFailureCount() >= s.breaker.config.failureThreshold) {
 			s.breaker.open(exec)
+			return
+		}
+
+		// Slow call rate threshold
+		if slowCallRateThreshold := s.breaker.config.slowCallRateThreshold; slowCallRateThreshold != 0 &&
+			s.latencies.slowCallRate(s.breaker.config.slowCallDuration) >= slowCallRateThreshold {
+			s.breaker.open(exec)
 		}
 	}
 }
 
+// Returns the stats for the current window.
+func (s *closedState[R]) windowStats() WindowStats {
+	return WindowStats{
+		Executions:  s.stats.getExecutionCount(),
+		Failures:    s.stats.getFailureCount(),
+		FailureRate: s.stats.getFailureRate(),
+		Successes:   s.stats.getSuccessCount(),
+		latencies:   s.latencies.sorted(),
+	}
+}
+
+// Records the latency of the execution, if latencies are being tracked.
+func (s *closedState[R]) recordLatency(exec failsafe.Execution[R]) {
+	if s.latencies != nil && exec != nil {
+		s.latencies.record(exec.ElapsedAttemptTime())
+	}
+}
+
 type openState[R any] struct {
 	breaker   *circuitBreaker[R]
 	stats     circuitStats
@@ -176,7 +214,7 @@ func (s *halfOpenState[R]) checkThresholdAndReleasePermit(exec failsafe.Executio
 	}
 
 	if successesExceeded {
-		s.breaker.close()
+		s.breaker.close(exec)
 	} else if failuresExceeded {
 		s.breaker.open(exec)
 	}
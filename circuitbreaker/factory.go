@@ -0,0 +1,123 @@
+package circuitbreaker
+
+import (
+	"sync"
+	"time"
+
+	"github.com/failsafe-go/failsafe-go/internal/util"
+)
+
+/*
+CircuitBreakerFactory creates and caches named CircuitBreakers, such as one per endpoint, that share a default
+configuration which can be overridden for individual names. Repeated calls to Get with the same name return the same
+CircuitBreaker, so its state is shared by executions for that name. CircuitBreakers for names that are no longer used,
+such as per-host or per-tenant CircuitBreakers, can be evicted via WithIdleTimeout.
+
+This type is concurrency safe.
+*/
+type CircuitBreakerFactory[R any] interface {
+	// Override registers the configure func to be called with the builder for the name, after the default configuration
+	// has been applied, allowing the name's CircuitBreaker to be configured differently than the defaults. Overrides only
+	// apply to CircuitBreakers that have not yet been created via Get.
+	Override(name string, configure func(builder CircuitBreakerBuilder[R])) CircuitBreakerFactory[R]
+
+	// WithIdleTimeout configures the factory to evict CircuitBreakers that have not been returned by Get within the
+	// idleTimeout, so that CircuitBreakers for names that are no longer used are not retained indefinitely. Only
+	// CircuitBreakers in a ClosedState are evicted, since evicting an open CircuitBreaker would discard its protection. An
+	// evicted CircuitBreaker is replaced with a new one the next time its name is passed to Get. Evictions are performed
+	// lazily, during calls to Get. An idleTimeout of 0, which is the default, disables eviction.
+	WithIdleTimeout(idleTimeout time.Duration) CircuitBreakerFactory[R]
+
+	// Get returns the CircuitBreaker for the name, creating it if needed.
+	Get(name string) CircuitBreaker[R]
+
+	// Len returns the number of CircuitBreakers that the factory currently holds.
+	Len() int
+}
+
+type circuitBreakerFactory[R any] struct {
+	defaults func(name string) CircuitBreakerBuilder[R]
+	clock    util.Clock
+
+	mtx sync.Mutex
+	// Guarded by mtx
+	overrides   map[string]func(CircuitBreakerBuilder[R])
+	breakers    map[string]*factoryEntry[R]
+	idleTimeout time.Duration
+	lastEvicted int64
+}
+
+type factoryEntry[R any] struct {
+	breaker      CircuitBreaker[R]
+	lastAccessed int64
+}
+
+// NewFactory returns a new CircuitBreakerFactory for execution result type R that creates CircuitBreakers using the
+// builders returned by the defaults func, which is called with the name of each CircuitBreaker that is created. The
+// defaults func must return a new builder each time it's called.
+func NewFactory[R any](defaults func(name string) CircuitBreakerBuilder[R]) CircuitBreakerFactory[R] {
+	return &circuitBreakerFactory[R]{
+		defaults:  defaults,
+		clock:     util.NewClock(),
+		overrides: make(map[string]func(CircuitBreakerBuilder[R])),
+		breakers:  make(map[string]*factoryEntry[R]),
+	}
+}
+
+func (f *circuitBreakerFactory[R]) Override(name string, configure func(builder CircuitBreakerBuilder[R])) CircuitBreakerFactory[R] {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.overrides[name] = configure
+	return f
+}
+
+func (f *circuitBreakerFactory[R]) WithIdleTimeout(idleTimeout time.Duration) CircuitBreakerFactory[R] {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.idleTimeout = idleTimeout
+	return f
+}
+
+func (f *circuitBreakerFactory[R]) Get(name string) CircuitBreaker[R] {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	now := f.clock.CurrentUnixNano()
+	f.evictIdle(now)
+	if entry, ok := f.breakers[name]; ok {
+		entry.lastAccessed = now
+		return entry.breaker
+	}
+	builder := f.defaults(name)
+	if configure, ok := f.overrides[name]; ok {
+		configure(builder)
+	}
+	breaker := builder.Build()
+	f.breakers[name] = &factoryEntry[R]{
+		breaker:      breaker,
+		lastAccessed: now,
+	}
+	return breaker
+}
+
+func (f *circuitBreakerFactory[R]) Len() int {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	return len(f.breakers)
+}
+
+// evictIdle evicts closed CircuitBreakers that have not been accessed within the idleTimeout. To avoid scanning the
+// breakers on every call, evictions are performed at most once per idleTimeout.
+//
+// Requires external locking.
+func (f *circuitBreakerFactory[R]) evictIdle(now int64) {
+	idleTimeout := f.idleTimeout.Nanoseconds()
+	if idleTimeout <= 0 || now-f.lastEvicted < idleTimeout {
+		return
+	}
+	f.lastEvicted = now
+	for name, entry := range f.breakers {
+		if now-entry.lastAccessed >= idleTimeout && entry.breaker.IsClosed() {
+			delete(f.breakers, name)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package circuitbreaker
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+)
+
+func TestFactoryGet(t *testing.T) {
+	// Given
+	factory := NewFactory[any](func(name string) CircuitBreakerBuilder[any] {
+		return Builder[any]().WithFailureThreshold(2).WithDelay(time.Minute)
+	})
+	factory.Override("payments", func(builder CircuitBreakerBuilder[any]) {
+		builder.WithFailureThreshold(1)
+	})
+
+	// When
+	users := factory.Get("users")
+	users.RecordFailure()
+	payments := factory.Get("payments")
+	payments.RecordFailure()
+
+	// Then
+	assert.Same(t, users, factory.Get("users"))
+	assert.True(t, factory.Get("users").IsClosed(), "users should use the default failure threshold")
+	assert.True(t, factory.Get("payments").IsOpen(), "payments should use the overridden failure threshold")
+	assert.Equal(t, time.Minute, factory.Get("payments").RemainingDelay().Round(time.Minute))
+}
+
+func TestFactoryGetConcurrently(t *testing.T) {
+	// Given
+	factory := NewFactory[any](func(name string) CircuitBreakerBuilder[any] {
+		return Builder[any]()
+	})
+	breakers := make([]CircuitBreaker[any], 10)
+	var wg sync.WaitGroup
+
+	// When
+	for i := range breakers {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			breakers[i] = factory.Get("shared")
+		}()
+	}
+	wg.Wait()
+
+	// Then
+	for _, breaker := range breakers {
+		assert.Same(t, breakers[0], breaker)
+	}
+}
+
+func TestFactoryWithIdleTimeout(t *testing.T) {
+	// Given
+	clock := &testutil.TestClock{}
+	factory := NewFactory[any](func(name string) CircuitBreakerBuilder[any] {
+		return Builder[any]().WithDelay(time.Minute)
+	}).WithIdleTimeout(10 * time.Second)
+	factory.(*circuitBreakerFactory[any]).clock = clock
+	idle := factory.Get("idle")
+	open := factory.Get("open")
+	open.Open()
+	active := factory.Get("active")
+
+	// When
+	clock.CurrentTime = (5 * time.Second).Nanoseconds()
+	assert.Same(t, active, factory.Get("active"))
+	clock.CurrentTime = (10 * time.Second).Nanoseconds()
+	factory.Get("active")
+
+	// Then
+	assert.Equal(t, 2, factory.Len(), "idle breaker should be evicted")
+	assert.Same(t, open, factory.Get("open"), "open breaker should not be evicted")
+	assert.Same(t, active, factory.Get("active"), "recently accessed breaker should not be evicted")
+	assert.NotSame(t, idle, factory.Get("idle"), "evicted breaker should be replaced")
+	assert.Equal(t, 3, factory.Len())
+}
@@ -16,7 +16,7 @@ func TestTryAcquirePermit(t *testing.T) {
 	breaker := Builder[any]().WithDelayFunc(func(exec failsafe.ExecutionAttempt[any]) time.Duration {
 		return 100 * time.Millisecond
 	}).Build().(*circuitBreaker[any])
-	breaker.open(testutil.TestExecution[any]{})
+	breaker.open(&testutil.TestExecution[any]{})
 	assert.True(t, breaker.IsOpen())
 	assert.False(t, breaker.TryAcquirePermit())
 
@@ -32,7 +32,7 @@ func TestRemainingDelay(t *testing.T) {
 	breaker := Builder[any]().WithDelayFunc(func(exec failsafe.ExecutionAttempt[any]) time.Duration {
 		return 1 * time.Second
 	}).Build().(*circuitBreaker[any])
-	breaker.open(testutil.TestExecution[any]{})
+	breaker.open(&testutil.TestExecution[any]{})
 
 	// When / Then
 	remainingDelay := breaker.RemainingDelay()
@@ -52,7 +52,7 @@ func TestNoRemainingDelay(t *testing.T) {
 	assert.Equal(t, time.Duration(0), breaker.RemainingDelay())
 
 	// When
-	breaker.open(testutil.TestExecution[any]{})
+	breaker.open(&testutil.TestExecution[any]{})
 	assert.True(t, breaker.RemainingDelay() > 0)
 	time.Sleep(50 * time.Millisecond)
 
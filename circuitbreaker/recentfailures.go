@@ -0,0 +1,53 @@
+package circuitbreaker
+
+import (
+	"time"
+)
+
+// RecentFailure is a summary of a failure that was recorded by a CircuitBreaker. See
+// CircuitBreakerBuilder.WithRecentFailures.
+type RecentFailure struct {
+	// The summary of the failure's result or error
+	Summary string
+	// The time that the failure was recorded
+	Time time.Time
+}
+
+// recentFailures retains summaries of the most recent failures that a CircuitBreaker recorded.
+//
+// This type is not concurrency safe.
+type recentFailures struct {
+	failures []RecentFailure // A circular buffer of the most recent failures
+	head     int
+	size     int
+}
+
+func newRecentFailures(size int) *recentFailures {
+	return &recentFailures{
+		failures: make([]RecentFailure, size),
+	}
+}
+
+// record records the failure, replacing the oldest failure if the buffer is full.
+func (r *recentFailures) record(failure RecentFailure) {
+	r.failures[r.head] = failure
+	r.head = (r.head + 1) % len(r.failures)
+	r.size = min(r.size+1, len(r.failures))
+}
+
+// snapshot returns a copy of the recent failures, from oldest to newest.
+func (r *recentFailures) snapshot() []RecentFailure {
+	result := make([]RecentFailure, 0, r.size)
+	start := (r.head - r.size + len(r.failures)) % len(r.failures)
+	for i := 0; i < r.size; i++ {
+		result = append(result, r.failures[(start+i)%len(r.failures)])
+	}
+	return result
+}
+
+// reset clears the recent failures.
+func (r *recentFailures) reset() {
+	clear(r.failures)
+	r.head = 0
+	r.size = 0
+}
@@ -0,0 +1,58 @@
+package circuitbreaker
+
+import (
+	"sort"
+	"sync"
+)
+
+// stateful is a CircuitBreaker of any result type whose state can be observed.
+type stateful interface {
+	State() State
+}
+
+// registry holds named CircuitBreakers, which are registered via CircuitBreakerBuilder.WithName.
+var registry = struct {
+	mtx      sync.RWMutex
+	breakers map[string]stateful
+}{breakers: make(map[string]stateful)}
+
+func register(name string, breaker stateful) {
+	registry.mtx.Lock()
+	defer registry.mtx.Unlock()
+	registry.breakers[name] = breaker
+}
+
+// Unregister removes the named CircuitBreaker from the registry, if present.
+func Unregister(name string) {
+	registry.mtx.Lock()
+	defer registry.mtx.Unlock()
+	delete(registry.breakers, name)
+}
+
+// States returns the current state of each named CircuitBreaker, keyed by name.
+func States() map[string]State {
+	registry.mtx.RLock()
+	defer registry.mtx.RUnlock()
+	result := make(map[string]State, len(registry.breakers))
+	for name, breaker := range registry.breakers {
+		result[name] = breaker.State()
+	}
+	return result
+}
+
+// OpenBreakers returns the sorted names of named CircuitBreakers that are currently in the OpenState.
+func OpenBreakers() []string {
+	var result []string
+	for name, state := range States() {
+		if state == OpenState {
+			result = append(result, name)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// OpenCount returns the number of named CircuitBreakers that are currently in the OpenState.
+func OpenCount() int {
+	return len(OpenBreakers())
+}
@@ -0,0 +1,112 @@
+package circuitbreaker
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"github.com/failsafe-go/failsafe-go/internal/util"
+)
+
+// WindowStats contains stats for the executions recorded within a CircuitBreaker's current thresholding window, when in
+// a ClosedState. See CircuitBreakerBuilder.WithHealthFunc.
+type WindowStats struct {
+	// The number of executions recorded in the window, which is the window's throughput
+	Executions uint
+	// The number of failures recorded in the window
+	Failures uint
+	// The percentage rate of failures recorded in the window, from 0 to 100
+	FailureRate uint
+	// The number of successes recorded in the window
+	Successes uint
+
+	// The latencies of executions in the window, sorted from fastest to slowest
+	latencies []time.Duration
+}
+
+// LatencyPercentile returns the latency, at the percentile from 0 to 100, of executions that were performed through the
+// CircuitBreaker within the window, else 0 if there were none. Results that are recorded manually, such as via
+// CircuitBreaker.RecordSuccess, do not have a latency and are not included.
+func (s WindowStats) LatencyPercentile(percentile float64) time.Duration {
+	if len(s.latencies) == 0 {
+		return 0
+	}
+	index := int(math.Ceil(percentile/100*float64(len(s.latencies)))) - 1
+	return s.latencies[max(0, min(index, len(s.latencies)-1))]
+}
+
+type latencySample struct {
+	latency  time.Duration
+	unixNano int64
+}
+
+// latencyWindow retains the latencies of executions within a count or time based thresholding window.
+//
+// This type is not concurrency safe.
+type latencyWindow struct {
+	clock    util.Clock
+	capacity uint          // The max number of latencies to retain, for count based windows
+	period   time.Duration // The period to retain latencies for, for time based windows, else 0
+	samples  []latencySample
+}
+
+func newLatencyWindow[R any](config *circuitBreakerConfig[R], capacity uint) *latencyWindow {
+	return &latencyWindow{
+		clock:    config.clock,
+		capacity: capacity,
+		period:   config.failureThresholdingPeriod,
+	}
+}
+
+// record records the latency, evicting any latencies that are no longer in the window.
+func (w *latencyWindow) record(latency time.Duration) {
+	w.samples = append(w.samples, latencySample{
+		latency:  latency,
+		unixNano: w.clock.CurrentUnixNano(),
+	})
+	w.evict()
+}
+
+// evict removes latencies that are no longer in the window.
+func (w *latencyWindow) evict() {
+	if w.period != 0 {
+		cutoff := w.clock.CurrentUnixNano() - w.period.Nanoseconds()
+		i := 0
+		for i < len(w.samples) && w.samples[i].unixNano <= cutoff {
+			i++
+		}
+		w.samples = w.samples[i:]
+	} else if uint(len(w.samples)) > w.capacity {
+		w.samples = w.samples[uint(len(w.samples))-w.capacity:]
+	}
+}
+
+// sorted returns a copy of the latencies in the window, sorted from fastest to slowest.
+func (w *latencyWindow) sorted() []time.Duration {
+	w.evict()
+	result := make([]time.Duration, len(w.samples))
+	for i, sample := range w.samples {
+		result[i] = sample.latency
+	}
+	slices.Sort(result)
+	return result
+}
+
+// slowCallRate returns the percentage rate, from 0 to 100, of latencies in the window that exceed the slowCallDuration.
+func (w *latencyWindow) slowCallRate(slowCallDuration time.Duration) uint {
+	w.evict()
+	if len(w.samples) == 0 {
+		return 0
+	}
+	slowCalls := 0
+	for _, sample := range w.samples {
+		if sample.latency > slowCallDuration {
+			slowCalls++
+		}
+	}
+	return uint(slowCalls * 100 / len(w.samples))
+}
+
+func (w *latencyWindow) reset() {
+	w.samples = nil
+}
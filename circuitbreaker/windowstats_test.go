@@ -0,0 +1,50 @@
+package circuitbreaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+)
+
+func TestCountingLatencyWindow(t *testing.T) {
+	window := &latencyWindow{clock: &testutil.TestClock{}, capacity: 3}
+	for i := 1; i <= 5; i++ {
+		window.record(time.Duration(i) * time.Millisecond)
+	}
+
+	stats := WindowStats{latencies: window.sorted()}
+	assert.Equal(t, 3*time.Millisecond, stats.LatencyPercentile(0))
+	assert.Equal(t, 4*time.Millisecond, stats.LatencyPercentile(50))
+	assert.Equal(t, 5*time.Millisecond, stats.LatencyPercentile(99))
+}
+
+func TestTimedLatencyWindow(t *testing.T) {
+	clock := &testutil.TestClock{}
+	window := &latencyWindow{clock: clock, period: 10 * time.Second}
+	for i := 1; i <= 5; i++ {
+		clock.CurrentTime = int64(i) * time.Second.Nanoseconds()
+		window.record(time.Duration(6-i) * time.Millisecond)
+	}
+	assert.Equal(t, []time.Duration{1 * time.Millisecond, 2 * time.Millisecond, 3 * time.Millisecond, 4 * time.Millisecond, 5 * time.Millisecond}, window.sorted())
+
+	clock.CurrentTime = 13 * time.Second.Nanoseconds()
+	assert.Equal(t, []time.Duration{1 * time.Millisecond, 2 * time.Millisecond}, window.sorted())
+
+	window.reset()
+	assert.Equal(t, WindowStats{}.LatencyPercentile(99), WindowStats{latencies: window.sorted()}.LatencyPercentile(99))
+}
+
+func TestSlowCallRate(t *testing.T) {
+	window := &latencyWindow{clock: &testutil.TestClock{}, capacity: 4}
+	assert.Equal(t, uint(0), window.slowCallRate(10*time.Millisecond))
+
+	for _, latency := range []time.Duration{5, 10, 15, 20, 25} {
+		window.record(latency * time.Millisecond)
+	}
+	assert.Equal(t, uint(50), window.slowCallRate(15*time.Millisecond))
+	assert.Equal(t, uint(75), window.slowCallRate(10*time.Millisecond))
+	assert.Equal(t, uint(0), window.slowCallRate(25*time.Millisecond))
+}
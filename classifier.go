@@ -0,0 +1,70 @@
+package failsafe
+
+import (
+	"sync/atomic"
+)
+
+// Classification indicates how an error should be handled by policies.
+type Classification int
+
+func (c Classification) String() string {
+	switch c {
+	case Retryable:
+		return "retryable"
+	case Fatal:
+		return "fatal"
+	case Ignore:
+		return "ignore"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	// Retryable indicates an error is a failure that may be handled, such as by retrying.
+	Retryable Classification = iota
+
+	// Fatal indicates an error is a failure that should not be retried or hedged.
+	Fatal
+
+	// Ignore indicates an error should not be considered a failure.
+	Ignore
+)
+
+var errorClassifier atomic.Pointer[func(error) Classification]
+
+/*
+SetErrorClassifier registers a classifier that determines how errors are handled by policies by default. This allows
+error classification to be defined once and consulted by all policies:
+
+  - Policies that handle failures, such as RetryPolicy, CircuitBreaker, and Fallback, consider Retryable and Fatal errors
+    to be failures, and Ignore errors to be successes.
+  - RetryPolicy aborts retries for Fatal errors, in addition to any errors or results that match its abort conditions.
+  - HedgePolicy cancels outstanding hedges for any result other than a Retryable error.
+
+Except for aborting Fatal errors, the classifier is only consulted when a policy has no conditions configured for the
+same purpose, so conditions configured on a policy, such as HandleErrors, HandleIf, CancelOnErrors, and CancelIf, take
+precedence over the classifier. Passing nil removes any registered classifier.
+
+This function is concurrency safe, but a classifier should typically be registered once, before any executions are
+performed.
+*/
+func SetErrorClassifier(classifier func(error) Classification) {
+	if classifier == nil {
+		errorClassifier.Store(nil)
+	} else {
+		errorClassifier.Store(&classifier)
+	}
+}
+
+// ClassifyError returns the classification of the err according to the registered error classifier, along with whether
+// a classifier is registered. Returns false if err is nil or no classifier is registered.
+func ClassifyError(err error) (Classification, bool) {
+	if err == nil {
+		return 0, false
+	}
+	if classifier := errorClassifier.Load(); classifier != nil {
+		return (*classifier)(err), true
+	}
+	return 0, false
+}
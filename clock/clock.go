@@ -0,0 +1,94 @@
+package clock
+
+import (
+	"time"
+)
+
+// Clock provides the current time and schedules timers. Policies that measure time or wait, such as RetryPolicy,
+// Timeout, RateLimiter, and CircuitBreaker, can be configured with a Clock via their builders, which allows a FakeClock to
+// control time in tests.
+//
+// Implementations must be concurrency safe.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// Since returns the time elapsed since t.
+	Since(t time.Time) time.Duration
+
+	// NewTimer returns a Timer that sends the current time on its channel after at least the duration d.
+	NewTimer(d time.Duration) Timer
+
+	// AfterFunc returns a Timer that calls f after at least the duration d. The Timer's channel is not used.
+	AfterFunc(d time.Duration, f func()) Timer
+
+	// NewTicker returns a Ticker that sends the current time on its channel every period d.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is a single event, similar to a time.Timer.
+type Timer interface {
+	// C returns the channel that the time is sent on when the Timer fires.
+	C() <-chan time.Time
+
+	// Stop prevents the Timer from firing. Returns false if the Timer already fired or was stopped, else true.
+	Stop() bool
+
+	// Reset changes the Timer to fire after the duration d. Returns true if the Timer had been active, else false.
+	Reset(d time.Duration) bool
+}
+
+// Ticker delivers ticks at intervals, similar to a time.Ticker.
+type Ticker interface {
+	// C returns the channel that ticks are sent on.
+	C() <-chan time.Time
+
+	// Stop turns off the Ticker.
+	Stop()
+
+	// Reset stops the Ticker and resets its period to the duration d.
+	Reset(d time.Duration)
+}
+
+type realClock struct{}
+
+// New returns a Clock that uses the time package.
+func New() Clock {
+	return realClock{}
+}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Since(t time.Time) time.Duration {
+	return time.Since(t)
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return &realTimer{time.NewTimer(d)}
+}
+
+func (realClock) AfterFunc(d time.Duration, f func()) Timer {
+	return &realTimer{time.AfterFunc(d, f)}
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return &realTicker{time.NewTicker(d)}
+}
+
+type realTimer struct {
+	*time.Timer
+}
+
+func (t *realTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+type realTicker struct {
+	*time.Ticker
+}
+
+func (t *realTicker) C() <-chan time.Time {
+	return t.Ticker.C
+}
@@ -0,0 +1,3 @@
+// Package clock provides a Clock that policies measure time and schedule timers with, along with a FakeClock for
+// testing time-driven behavior without real delays.
+package clock
@@ -0,0 +1,197 @@
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+/*
+FakeClock is a Clock whose time only changes when it's advanced, which allows time-driven behavior to be tested without
+real delays. Timers and tickers fire when the FakeClock is advanced past their deadlines. Funcs scheduled via AfterFunc
+are called synchronously by Advance.
+
+Since executions typically wait for timers in other goroutines, tests can use BlockUntilTimers to wait until an execution
+has scheduled a timer before advancing the clock.
+
+This type is concurrency safe.
+*/
+type FakeClock struct {
+	mtx  sync.Mutex
+	cond *sync.Cond
+	// Guarded by mtx
+	now    time.Time
+	timers []*fakeTimer
+}
+
+var _ Clock = &FakeClock{}
+
+// fakeTimer is a pending timer, ticker, or func for a FakeClock.
+type fakeTimer struct {
+	clock    *FakeClock
+	deadline time.Time
+	c        chan time.Time
+	f        func()
+	// Greater than 0 for tickers
+	period time.Duration
+}
+
+// NewFakeClock returns a FakeClock whose current time is now.
+func NewFakeClock(now time.Time) *FakeClock {
+	c := &FakeClock{now: now}
+	c.cond = sync.NewCond(&c.mtx)
+	return c
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.now
+}
+
+func (c *FakeClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	return c.schedule(&fakeTimer{clock: c, c: make(chan time.Time, 1)}, d)
+}
+
+func (c *FakeClock) AfterFunc(d time.Duration, f func()) Timer {
+	return c.schedule(&fakeTimer{clock: c, f: f}, d)
+}
+
+func (c *FakeClock) NewTicker(d time.Duration) Ticker {
+	return &fakeTicker{c.schedule(&fakeTimer{clock: c, c: make(chan time.Time, 1), period: d}, d)}
+}
+
+// Advance advances the clock by the duration d, firing any timers whose deadlines are reached, in deadline order.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.Set(c.Now().Add(d))
+}
+
+// Set sets the clock's current time to now, firing any timers whose deadlines are reached, in deadline order.
+func (c *FakeClock) Set(now time.Time) {
+	c.mtx.Lock()
+	c.now = now
+	var due []*fakeTimer
+	for {
+		t := c.nextDue()
+		if t == nil {
+			break
+		}
+		due = append(due, t)
+	}
+	c.mtx.Unlock()
+
+	for _, t := range due {
+		if t.f != nil {
+			t.f()
+		} else {
+			select {
+			case t.c <- now:
+			default:
+				// Drop the tick, like time.Ticker does for slow receivers
+			}
+		}
+	}
+}
+
+// PendingTimers returns the number of timers and tickers that have not yet fired or been stopped.
+func (c *FakeClock) PendingTimers() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return len(c.timers)
+}
+
+// BlockUntilTimers blocks until at least n timers and tickers are pending.
+func (c *FakeClock) BlockUntilTimers(n int) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	for len(c.timers) < n {
+		c.cond.Wait()
+	}
+}
+
+// nextDue removes and returns the earliest timer that is due, rescheduling it if it's a ticker, else returns nil.
+// Requires external locking.
+func (c *FakeClock) nextDue() *fakeTimer {
+	if len(c.timers) == 0 || c.timers[0].deadline.After(c.now) {
+		return nil
+	}
+	t := c.timers[0]
+	c.timers = c.timers[1:]
+	if t.period > 0 {
+		// Reschedule tickers after the current time, dropping any missed ticks
+		for !t.deadline.After(c.now) {
+			t.deadline = t.deadline.Add(t.period)
+		}
+		c.add(t)
+	}
+	return t
+}
+
+func (c *FakeClock) schedule(t *fakeTimer, d time.Duration) *fakeTimer {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	t.deadline = c.now.Add(d)
+	c.add(t)
+	return t
+}
+
+// add adds the timer in deadline order. Requires external locking.
+func (c *FakeClock) add(t *fakeTimer) {
+	i := sort.Search(len(c.timers), func(i int) bool {
+		return c.timers[i].deadline.After(t.deadline)
+	})
+	c.timers = append(c.timers, nil)
+	copy(c.timers[i+1:], c.timers[i:])
+	c.timers[i] = t
+	c.cond.Broadcast()
+}
+
+// remove removes the timer, returning whether it was pending. Requires external locking.
+func (c *FakeClock) remove(t *fakeTimer) bool {
+	for i, pending := range c.timers {
+		if pending == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mtx.Lock()
+	defer t.clock.mtx.Unlock()
+	return t.clock.remove(t)
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	t.clock.mtx.Lock()
+	defer t.clock.mtx.Unlock()
+	active := t.clock.remove(t)
+	t.deadline = t.clock.now.Add(d)
+	if t.period > 0 {
+		t.period = d
+	}
+	t.clock.add(t)
+	return active
+}
+
+// fakeTicker adapts a fakeTimer with a period to the Ticker interface.
+type fakeTicker struct {
+	*fakeTimer
+}
+
+func (t *fakeTicker) Stop() {
+	t.fakeTimer.Stop()
+}
+
+func (t *fakeTicker) Reset(d time.Duration) {
+	t.fakeTimer.Reset(d)
+}
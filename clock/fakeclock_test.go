@@ -0,0 +1,75 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeClockTimer(t *testing.T) {
+	// Given
+	start := time.Unix(0, 0)
+	clock := NewFakeClock(start)
+	timer := clock.NewTimer(time.Second)
+
+	// When / Then
+	clock.Advance(999 * time.Millisecond)
+	assert.Len(t, timer.C(), 0)
+	clock.Advance(time.Millisecond)
+	assert.Equal(t, start.Add(time.Second), <-timer.C())
+	assert.False(t, timer.Stop())
+	assert.Equal(t, 0, clock.PendingTimers())
+
+	// When reset
+	assert.False(t, timer.Reset(time.Second))
+	assert.True(t, timer.Stop())
+	clock.Advance(time.Second)
+
+	// Then
+	assert.Len(t, timer.C(), 0)
+}
+
+func TestFakeClockAfterFuncOrder(t *testing.T) {
+	// Given
+	clock := NewFakeClock(time.Unix(0, 0))
+	var calls []int
+	clock.AfterFunc(2*time.Second, func() { calls = append(calls, 2) })
+	clock.AfterFunc(time.Second, func() { calls = append(calls, 1) })
+	clock.AfterFunc(3*time.Second, func() { calls = append(calls, 3) })
+
+	// When
+	clock.Advance(2 * time.Second)
+
+	// Then
+	assert.Equal(t, []int{1, 2}, calls)
+	assert.Equal(t, 1, clock.PendingTimers())
+}
+
+func TestFakeClockTicker(t *testing.T) {
+	// Given
+	clock := NewFakeClock(time.Unix(0, 0))
+	ticker := clock.NewTicker(time.Second)
+
+	// When / Then
+	clock.Advance(time.Second)
+	<-ticker.C()
+	clock.Advance(3500 * time.Millisecond)
+	<-ticker.C()
+	assert.Len(t, ticker.C(), 0)
+	assert.Equal(t, 1, clock.PendingTimers())
+
+	ticker.Stop()
+	assert.Equal(t, 0, clock.PendingTimers())
+}
+
+func TestFakeClockBlockUntilTimers(t *testing.T) {
+	clock := NewFakeClock(time.Unix(0, 0))
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		clock.NewTimer(time.Second)
+	}()
+
+	clock.BlockUntilTimers(1)
+	assert.Equal(t, 1, clock.PendingTimers())
+}
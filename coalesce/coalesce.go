@@ -0,0 +1,127 @@
+package coalesce
+
+import (
+	"sync"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/common"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+/*
+Coalesce is a policy that deduplicates concurrent executions that have the same key. The first execution for a key
+performs the policies and func that are composed inside of the Coalesce, and concurrent executions with the same key
+wait for and share its result, rather than executing themselves. Once the execution for a key completes, the next
+execution for the key is performed again.
+
+A Coalesce composes well inside of a CachePolicy, where it prevents concurrent cache misses for the same key from all
+performing the same execution.
+
+Executions that are waiting for a result return early if they're canceled. Since waiting executions share the result of
+the execution they're waiting for, they also share its failure if that execution is canceled.
+
+This type is concurrency safe.
+*/
+type Coalesce[R any] interface {
+	failsafe.Policy[R]
+}
+
+/*
+CoalesceBuilder builds Coalesce instances.
+
+This type is not concurrency safe.
+*/
+type CoalesceBuilder[R any] interface {
+	// WithKeyFunc configures the keyFunc that returns the key to coalesce executions by. If no keyFunc is configured, all
+	// concurrent executions share the same key.
+	WithKeyFunc(keyFunc func(exec failsafe.Execution[R]) string) CoalesceBuilder[R]
+
+	// OnCoalesced registers the listener to be called when an execution shares the result of another execution rather
+	// than executing itself.
+	OnCoalesced(listener func(failsafe.ExecutionEvent[R])) CoalesceBuilder[R]
+
+	// Build returns a new Coalesce using the builder's configuration.
+	Build() Coalesce[R]
+}
+
+type coalesceConfig[R any] struct {
+	keyFunc     func(exec failsafe.Execution[R]) string
+	onCoalesced func(failsafe.ExecutionEvent[R])
+}
+
+var _ CoalesceBuilder[any] = &coalesceConfig[any]{}
+
+type coalesce[R any] struct {
+	config *coalesceConfig[R]
+
+	mtx sync.Mutex
+	// Guarded by mtx
+	calls map[string]*call[R]
+}
+
+// call is an in-flight execution for a key, whose result is shared with concurrent executions for the key.
+type call[R any] struct {
+	// Closed when the result is available
+	done chan struct{}
+	// nil if the execution panicked
+	result *common.PolicyResult[R]
+}
+
+// With returns a new Coalesce for execution result type R that coalesces executions by the key returned by the keyFunc.
+func With[R any](keyFunc func(exec failsafe.Execution[R]) string) Coalesce[R] {
+	return Builder[R]().WithKeyFunc(keyFunc).Build()
+}
+
+// Builder returns a CoalesceBuilder for execution result type R.
+func Builder[R any]() CoalesceBuilder[R] {
+	return &coalesceConfig[R]{}
+}
+
+func (c *coalesceConfig[R]) WithKeyFunc(keyFunc func(exec failsafe.Execution[R]) string) CoalesceBuilder[R] {
+	c.keyFunc = keyFunc
+	return c
+}
+
+func (c *coalesceConfig[R]) OnCoalesced(listener func(failsafe.ExecutionEvent[R])) CoalesceBuilder[R] {
+	c.onCoalesced = listener
+	return c
+}
+
+func (c *coalesceConfig[R]) Build() Coalesce[R] {
+	cCopy := *c
+	return &coalesce[R]{
+		config: &cCopy,
+		calls:  make(map[string]*call[R]),
+	}
+}
+
+// join returns the in-flight call for the key, and whether the caller should perform it, which is the case when no call
+// was already in-flight.
+func (c *coalesce[R]) join(key string) (*call[R], bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if existing, ok := c.calls[key]; ok {
+		return existing, false
+	}
+	newCall := &call[R]{done: make(chan struct{})}
+	c.calls[key] = newCall
+	return newCall, true
+}
+
+// complete records the result for the call and removes it, so that subsequent executions for the key are performed again.
+func (c *coalesce[R]) complete(key string, call *call[R], result *common.PolicyResult[R]) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	call.result = result
+	delete(c.calls, key)
+	close(call.done)
+}
+
+func (c *coalesce[R]) ToExecutor(_ R) any {
+	ce := &coalesceExecutor[R]{
+		BaseExecutor: &policy.BaseExecutor[R]{},
+		coalesce:     c,
+	}
+	ce.Executor = ce
+	return ce
+}
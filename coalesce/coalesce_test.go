@@ -0,0 +1,32 @@
+package coalesce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go/common"
+)
+
+// Asserts that calls are shared until they're completed.
+func TestJoinAndComplete(t *testing.T) {
+	// Given
+	c := Builder[any]().Build().(*coalesce[any])
+
+	// When
+	call1, leader1 := c.join("a")
+	call2, leader2 := c.join("a")
+	_, leaderB := c.join("b")
+
+	// Then
+	assert.True(t, leader1)
+	assert.False(t, leader2)
+	assert.True(t, leaderB)
+	assert.Same(t, call1, call2)
+
+	result := &common.PolicyResult[any]{Result: "foo"}
+	c.complete("a", call1, result)
+	assert.Same(t, result, call2.result)
+	_, leader3 := c.join("a")
+	assert.True(t, leader3)
+}
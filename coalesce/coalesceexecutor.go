@@ -0,0 +1,50 @@
+package coalesce
+
+import (
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/common"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// coalesceExecutor is a policy.Executor that handles failures according to a Coalesce.
+type coalesceExecutor[R any] struct {
+	*policy.BaseExecutor[R]
+	*coalesce[R]
+}
+
+var _ policy.Executor[any] = &coalesceExecutor[any]{}
+
+func (e *coalesceExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.PolicyResult[R]) func(failsafe.Execution[R]) *common.PolicyResult[R] {
+	return func(exec failsafe.Execution[R]) *common.PolicyResult[R] {
+		execInternal := exec.(policy.ExecutionInternal[R])
+		var key string
+		if e.config.keyFunc != nil {
+			key = e.config.keyFunc(exec)
+		}
+
+		call, leader := e.join(key)
+		if leader {
+			var result *common.PolicyResult[R]
+			defer func() {
+				e.complete(key, call, result)
+			}()
+			result = innerFn(exec)
+			return result
+		}
+
+		select {
+		case <-call.done:
+		case <-exec.Canceled():
+			_, cancelResult := execInternal.IsCanceledWithResult()
+			return cancelResult
+		}
+		if call.result == nil {
+			// The shared execution panicked, so perform the execution instead
+			return innerFn(exec)
+		}
+		if e.config.onCoalesced != nil {
+			e.config.onCoalesced(failsafe.ExecutionEvent[R]{ExecutionAttempt: execInternal})
+		}
+		return call.result
+	}
+}
@@ -0,0 +1,2 @@
+// Package coalesce provides a Coalesce policy.
+package coalesce
@@ -11,6 +11,8 @@ type PolicyResult[R any] struct {
 	Success bool
 	// SuccessAll indicates whether the policy and all inner policies were successful.
 	SuccessAll bool
+	// Fallback indicates whether the Result was provided by a Fallback.
+	Fallback bool
 }
 
 // WithDone returns a new Result for the done and success values.
@@ -0,0 +1,40 @@
+package failsafe
+
+import (
+	"encoding/json"
+)
+
+// configDescriber is implemented by policies that can describe their configuration for Executor.ConfigJSON.
+type configDescriber interface {
+	DescribeConfig() map[string]any
+}
+
+// executorConfigJSON is the JSON representation of an Executor's configuration.
+type executorConfigJSON struct {
+	Policies []policyConfigJSON `json:"policies"`
+}
+
+// policyConfigJSON is the JSON representation of a policy's configuration.
+type policyConfigJSON struct {
+	Type   string         `json:"type"`
+	Config map[string]any `json:"config,omitempty"`
+}
+
+func (e *executor[R]) ConfigJSON() ([]byte, error) {
+	policies := *e.policies.Load()
+	config := executorConfigJSON{
+		Policies: make([]policyConfigJSON, 0, len(policies)),
+	}
+	for _, p := range policies {
+		var unwrapped any = p
+		if wrapped, ok := unwrapped.(interface{ unwrap() any }); ok {
+			unwrapped = wrapped.unwrap()
+		}
+		policyConfig := policyConfigJSON{Type: policyType(p)}
+		if describer, ok := unwrapped.(configDescriber); ok {
+			policyConfig.Config = describer.DescribeConfig()
+		}
+		config.Policies = append(config.Policies, policyConfig)
+	}
+	return json.Marshal(config)
+}
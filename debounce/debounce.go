@@ -0,0 +1,141 @@
+package debounce
+
+import (
+	"sync"
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/common"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// Mode describes how a Debounce handles executions that occur within the cooldown of a previous execution.
+type Mode int
+
+const (
+	// Drop indicates that executions within the cooldown of a previous execution are dropped, and instead return the
+	// result of the previous execution, waiting for it to complete if needed.
+	Drop Mode = iota
+
+	// Delay indicates that executions within the cooldown of a previous execution are delayed until the end of the
+	// cooldown.
+	Delay
+)
+
+/*
+Debounce is a Policy that enforces a minimum time between consecutive executions, which is useful for deduplicating
+rapid, repeated triggers. Unlike a RateLimiter, which limits executions based on a budget of permits, a Debounce is based
+only on the time since the previous execution started.
+
+When an execution occurs within the cooldown of the previous execution, it's handled according to the Mode:
+
+  - With Drop, the execution is not performed, and instead returns the result of the previous execution. If the
+    previous execution is still in progress, this waits for it to complete. Concurrent executions within a cooldown are
+    coalesced into a single execution.
+  - With Delay, the execution is delayed until the end of the cooldown. Concurrent executions are each delayed by a
+    successive cooldown, so that they're performed in the order they arrived, one cooldown apart.
+
+Executions that are waiting for a previous execution or a delay can be canceled.
+
+This type is concurrency safe.
+*/
+type Debounce[R any] interface {
+	failsafe.Policy[R]
+}
+
+/*
+DebounceBuilder builds Debounce instances.
+
+This type is not concurrency safe.
+*/
+type DebounceBuilder[R any] interface {
+	// WithMode configures how executions within the cooldown of a previous execution are handled. The default is Drop.
+	WithMode(mode Mode) DebounceBuilder[R]
+
+	// Build returns a new Debounce using the builder's configuration.
+	Build() Debounce[R]
+}
+
+type debounceConfig[R any] struct {
+	cooldown time.Duration
+	mode     Mode
+}
+
+var _ DebounceBuilder[any] = &debounceConfig[any]{}
+
+type debounce[R any] struct {
+	config *debounceConfig[R]
+
+	mtx sync.Mutex
+	// Guarded by mtx
+	lastCall *call[R]
+}
+
+// call is a debounced execution that other executions within its cooldown may share the result of.
+type call[R any] struct {
+	startTime time.Time
+	done      chan struct{}
+	result    *common.PolicyResult[R]
+}
+
+func (c *call[R]) complete(result *common.PolicyResult[R]) {
+	c.result = result
+	close(c.done)
+}
+
+// WithCooldown returns a new Debounce for execution result type R that drops executions within the cooldown of a
+// previous execution.
+func WithCooldown[R any](cooldown time.Duration) Debounce[R] {
+	return BuilderWithCooldown[R](cooldown).Build()
+}
+
+// BuilderWithCooldown returns a DebounceBuilder for execution result type R which builds Debounces that enforce the
+// cooldown between executions.
+func BuilderWithCooldown[R any](cooldown time.Duration) DebounceBuilder[R] {
+	return &debounceConfig[R]{
+		cooldown: cooldown,
+	}
+}
+
+func (c *debounceConfig[R]) WithMode(mode Mode) DebounceBuilder[R] {
+	c.mode = mode
+	return c
+}
+
+func (c *debounceConfig[R]) Build() Debounce[R] {
+	dCopy := *c
+	return &debounce[R]{
+		config: &dCopy,
+	}
+}
+
+// reserve returns the call that an execution should use, and whether the call is shared with a previous execution.
+func (d *debounce[R]) reserve() (*call[R], bool) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	now := time.Now()
+	startTime := now
+	if d.lastCall != nil {
+		cooldownEnd := d.lastCall.startTime.Add(d.config.cooldown)
+		if now.Before(cooldownEnd) {
+			if d.config.mode == Drop {
+				return d.lastCall, true
+			}
+			startTime = cooldownEnd
+		}
+	}
+	d.lastCall = &call[R]{
+		startTime: startTime,
+		done:      make(chan struct{}),
+	}
+	return d.lastCall, false
+}
+
+func (d *debounce[R]) ToExecutor(_ R) any {
+	de := &debounceExecutor[R]{
+		BaseExecutor: &policy.BaseExecutor[R]{},
+		debounce:     d,
+	}
+	de.Executor = de
+	return de
+}
@@ -0,0 +1,52 @@
+package debounce
+
+import (
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/common"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// debounceExecutor is a policy.Executor that handles failures according to a Debounce.
+type debounceExecutor[R any] struct {
+	*policy.BaseExecutor[R]
+	*debounce[R]
+}
+
+var _ policy.Executor[any] = &debounceExecutor[any]{}
+
+func (e *debounceExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.PolicyResult[R]) func(failsafe.Execution[R]) *common.PolicyResult[R] {
+	return func(exec failsafe.Execution[R]) *common.PolicyResult[R] {
+		execInternal := exec.(policy.ExecutionInternal[R])
+		c, shared := e.reserve()
+
+		// Wait for the result of a previous execution
+		if shared {
+			select {
+			case <-c.done:
+				return c.result
+			case <-exec.Canceled():
+				_, cancelResult := execInternal.IsCanceledWithResult()
+				return cancelResult
+			}
+		}
+
+		// Delay until the end of a previous execution's cooldown
+		if delay := time.Until(c.startTime); delay > 0 {
+			timer := time.NewTimer(delay)
+			select {
+			case <-timer.C:
+			case <-exec.Canceled():
+				timer.Stop()
+				_, cancelResult := execInternal.IsCanceledWithResult()
+				c.complete(cancelResult)
+				return cancelResult
+			}
+		}
+
+		result := innerFn(exec)
+		c.complete(result)
+		return result
+	}
+}
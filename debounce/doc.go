@@ -0,0 +1,2 @@
+// Package debounce provides a Debounce policy.
+package debounce
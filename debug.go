@@ -0,0 +1,76 @@
+package failsafe
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// debugWriter writes human-readable lines describing an execution to a writer, for debugging. Writes are best effort,
+// and any write errors are ignored.
+type debugWriter struct {
+	w   io.Writer
+	mtx sync.Mutex
+}
+
+func (d *debugWriter) printf(format string, args ...any) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	_, _ = fmt.Fprintf(d.w, "failsafe: "+format+"\n", args...)
+}
+
+// executionDebugger writes debug lines for a single execution.
+type executionDebugger struct {
+	*debugWriter
+	lastAttemptEndTime atomic.Int64
+}
+
+// attemptStarted writes a line for the start of an attempt, including any delay since the last attempt ended, such as a
+// retry delay.
+func (d *executionDebugger) attemptStarted(attempt int, isRetry bool, isHedge bool) {
+	switch {
+	case isHedge:
+		d.printf("attempt %d started as a hedge", attempt)
+	case isRetry:
+		delay := time.Duration(time.Now().UnixNano() - d.lastAttemptEndTime.Load())
+		d.printf("attempt %d started as a retry after %s", attempt, formatDuration(delay))
+	default:
+		d.printf("attempt %d started", attempt)
+	}
+}
+
+// attemptDone writes a line for the outcome of an attempt.
+func (d *executionDebugger) attemptDone(attempt int, duration time.Duration, err error) {
+	d.lastAttemptEndTime.Store(time.Now().UnixNano())
+	if err != nil {
+		d.printf("attempt %d failed after %s: %v", attempt, formatDuration(duration), err)
+	} else {
+		d.printf("attempt %d completed after %s", attempt, formatDuration(duration))
+	}
+}
+
+// policyFailed writes a line for a policy that produced an error, either by rejecting an execution without calling the
+// policies or func that it's composed around, or by replacing their error.
+func (d *executionDebugger) policyFailed(policy string, index int, rejected bool, err error) {
+	if rejected {
+		d.printf("%s at index %d rejected the attempt: %v", policy, index, err)
+	} else {
+		d.printf("%s at index %d failed: %v", policy, index, err)
+	}
+}
+
+// executionDone writes a line for the outcome of an execution.
+func (d *executionDebugger) executionDone(stats ExecutionStats, success bool, err error) {
+	if success {
+		d.printf("execution succeeded after %d attempts in %s", stats.Attempts(), formatDuration(stats.ElapsedTime()))
+	} else {
+		d.printf("execution failed after %d attempts in %s: %v", stats.Attempts(), formatDuration(stats.ElapsedTime()), err)
+	}
+}
+
+// formatDuration formats the duration with millisecond precision.
+func formatDuration(d time.Duration) string {
+	return d.Round(time.Millisecond).String()
+}
@@ -12,4 +12,17 @@
 // This creates the following composition when executing the fn and handling its result:
 //
 //	Fallback(RetryPolicy(CircuitBreaker(fn)))
+//
+// # Listener Ordering
+//
+// For a single execution, listeners are called in a deterministic order that follows the composition. When an attempt
+// completes, listeners for the innermost policy are called first, followed by listeners for each policy outward. A
+// policy's OnSuccess or OnFailure listener is called before its other listeners for the same result, such as a
+// CircuitBreaker's OnOpen listener or a RetryPolicy's OnRetryScheduled listener. Once the execution is done, the
+// Executor's OnSuccess or OnFailure listener is called, followed by its OnDone listener. For the composition above,
+// a failed attempt that is retried calls listeners in this order:
+//
+//	CircuitBreaker OnFailure, CircuitBreaker OnOpen, RetryPolicy OnFailure, RetryPolicy OnRetryScheduled, RetryPolicy OnRetry
+//
+// Listeners for concurrent attempts, such as hedges, may be called concurrently.
 package failsafe
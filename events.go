@@ -25,6 +25,16 @@ type ExecutionDoneEvent[R any] struct {
 	Result R
 	// The execution error, else nil
 	Error error
+	// The time spent in policies, excluding the time spent in the execution's func, if overhead tracking is enabled via
+	// Executor.WithOverheadTracking, else 0
+	OverheadTime time.Duration
+
+	// The 1-based index of the policy that produced the failure, else 0
+	terminalPolicy int
+	// The type of the policy that produced the failure, else ""
+	terminalPolicyType string
+	// The results of each attempt, if they're being recorded
+	attemptResults []AttemptResult[R]
 }
 
 func newExecutionDoneEvent[R any](stats ExecutionStats, er *common.PolicyResult[R]) ExecutionDoneEvent[R] {
@@ -34,3 +44,27 @@ func newExecutionDoneEvent[R any](stats ExecutionStats, er *common.PolicyResult[
 		Error:          er.Error,
 	}
 }
+
+// TerminalPolicyIndex returns the index of the policy, in the order the policies were provided to the Executor, that
+// produced the final failing error, such as a CircuitBreaker that rejected an execution or a RetryPolicy whose retries
+// were exceeded. Returns -1 if the execution succeeded, if the failure was returned by the execution's func and was not
+// replaced by any policy, if the event was not provided by an Executor, or if tracking was not enabled via
+// Executor.WithTerminalPolicyTracking. Errors are identified by identity, so a failure with an error of an incomparable
+// type is not attributed to a policy.
+func (e ExecutionDoneEvent[R]) TerminalPolicyIndex() int {
+	return e.terminalPolicy - 1
+}
+
+// TerminalPolicyType returns the type of the policy that produced the final failing error, as the name of the package
+// that the policy belongs to, such as "circuitbreaker" or "retrypolicy". Returns "" if there is no terminal policy. See
+// TerminalPolicyIndex.
+func (e ExecutionDoneEvent[R]) TerminalPolicyType() string {
+	return e.terminalPolicyType
+}
+
+// AttemptResults returns the outcome of each of the execution's attempts, ordered by attempt, if attempt results are
+// being recorded via Executor.WithAttemptResults, else nil. Attempts that were rejected by a policy before reaching the
+// execution's func, such as by an open CircuitBreaker, are included along with the policy that rejected them.
+func (e ExecutionDoneEvent[R]) AttemptResults() []AttemptResult[R] {
+	return e.attemptResults
+}
@@ -0,0 +1,2 @@
+// Package exactlyonce provides an ExactlyOnce policy.
+package exactlyonce
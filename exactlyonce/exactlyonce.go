@@ -0,0 +1,142 @@
+package exactlyonce
+
+import (
+	"sync"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+/*
+ExactlyOnce is a policy that guards side-effecting executions against being performed more than once for the same key,
+across retries and across separate executions, such as when a message is redelivered. Before an execution is performed,
+the CompletionStore is checked for its key. If the key is already done, the stored result is returned without
+performing the execution. Otherwise the execution is performed, and the key is marked as done with its result only after
+the execution succeeds, including any inner policies.
+
+Unlike a cache, which avoids repeating work for performance, ExactlyOnce is intended for the safety of side effects.
+ExactlyOnce does not serialize concurrent executions that have the same key, which may each be performed if neither has
+completed. To guard against this, compose ExactlyOnce inside a KeyedMutex that uses the same key.
+
+This type is concurrency safe.
+*/
+type ExactlyOnce[R any] interface {
+	failsafe.Policy[R]
+}
+
+/*
+ExactlyOnceBuilder builds ExactlyOnce instances.
+
+This type is not concurrency safe.
+*/
+type ExactlyOnceBuilder[R any] interface {
+	// WithKeyFunc configures the keyFunc that returns the key to check and mark as done for an execution. If no keyFunc
+	// is configured, all executions share the same key.
+	WithKeyFunc(keyFunc func(exec failsafe.Execution[R]) string) ExactlyOnceBuilder[R]
+
+	// OnDuplicate registers the listener to be called when an execution is skipped because its key is already done.
+	OnDuplicate(listener func(event DuplicateEvent[R])) ExactlyOnceBuilder[R]
+
+	// Build returns a new ExactlyOnce using the builder's configuration.
+	Build() ExactlyOnce[R]
+}
+
+// DuplicateEvent indicates an execution was skipped because its key was already done.
+type DuplicateEvent[R any] struct {
+	failsafe.ExecutionAttempt[R]
+	// Key is the key that was already done.
+	Key string
+	// Result is the stored result that was returned in place of performing the execution.
+	Result R
+}
+
+/*
+CompletionStore stores the results of executions whose keys are done. Implementations may be backed by durable storage
+in order to guard against duplicate executions across processes.
+
+Implementations must be concurrency safe.
+*/
+type CompletionStore[R any] interface {
+	// IsDone returns the result that the key was marked as done with, along with whether the key is done.
+	IsDone(key string) (R, bool)
+
+	// MarkDone marks the key as done with the result.
+	MarkDone(key string, result R)
+}
+
+type exactlyOnceConfig[R any] struct {
+	store       CompletionStore[R]
+	keyFunc     func(exec failsafe.Execution[R]) string
+	onDuplicate func(DuplicateEvent[R])
+}
+
+var _ ExactlyOnceBuilder[any] = &exactlyOnceConfig[any]{}
+
+type exactlyOnce[R any] struct {
+	config *exactlyOnceConfig[R]
+}
+
+// With returns a new ExactlyOnce for execution result type R that records done keys, as returned by the keyFunc, in the
+// store.
+func With[R any](store CompletionStore[R], keyFunc func(exec failsafe.Execution[R]) string) ExactlyOnce[R] {
+	return Builder[R](store).WithKeyFunc(keyFunc).Build()
+}
+
+// Builder returns an ExactlyOnceBuilder for execution result type R that records done keys in the store.
+func Builder[R any](store CompletionStore[R]) ExactlyOnceBuilder[R] {
+	return &exactlyOnceConfig[R]{
+		store: store,
+	}
+}
+
+func (c *exactlyOnceConfig[R]) WithKeyFunc(keyFunc func(exec failsafe.Execution[R]) string) ExactlyOnceBuilder[R] {
+	c.keyFunc = keyFunc
+	return c
+}
+
+func (c *exactlyOnceConfig[R]) OnDuplicate(listener func(event DuplicateEvent[R])) ExactlyOnceBuilder[R] {
+	c.onDuplicate = listener
+	return c
+}
+
+func (c *exactlyOnceConfig[R]) Build() ExactlyOnce[R] {
+	eoCopy := *c
+	return &exactlyOnce[R]{
+		config: &eoCopy,
+	}
+}
+
+func (e *exactlyOnce[R]) ToExecutor(_ R) any {
+	eoe := &exactlyOnceExecutor[R]{
+		BaseExecutor: &policy.BaseExecutor[R]{},
+		exactlyOnce:  e,
+	}
+	eoe.Executor = eoe
+	return eoe
+}
+
+// NewMemoryStore returns a CompletionStore that stores done keys and their results in memory.
+func NewMemoryStore[R any]() CompletionStore[R] {
+	return &memoryStore[R]{
+		results: make(map[string]R),
+	}
+}
+
+type memoryStore[R any] struct {
+	mtx sync.RWMutex
+	// Guarded by mtx
+	results map[string]R
+}
+
+func (s *memoryStore[R]) IsDone(key string) (R, bool) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	result, ok := s.results[key]
+	return result, ok
+}
+
+func (s *memoryStore[R]) MarkDone(key string, result R) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.results[key] = result
+}
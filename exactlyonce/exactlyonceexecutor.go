@@ -0,0 +1,47 @@
+package exactlyonce
+
+import (
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/common"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// exactlyOnceExecutor is a policy.Executor that handles failures according to an ExactlyOnce.
+type exactlyOnceExecutor[R any] struct {
+	*policy.BaseExecutor[R]
+	*exactlyOnce[R]
+}
+
+var _ policy.Executor[any] = &exactlyOnceExecutor[any]{}
+
+func (e *exactlyOnceExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.PolicyResult[R]) func(failsafe.Execution[R]) *common.PolicyResult[R] {
+	return func(exec failsafe.Execution[R]) *common.PolicyResult[R] {
+		var key string
+		if e.config.keyFunc != nil {
+			key = e.config.keyFunc(exec)
+		}
+
+		// Return the stored result if the key is already done
+		if storedResult, done := e.config.store.IsDone(key); done {
+			if e.config.onDuplicate != nil {
+				e.config.onDuplicate(DuplicateEvent[R]{
+					ExecutionAttempt: exec,
+					Key:              key,
+					Result:           storedResult,
+				})
+			}
+			return &common.PolicyResult[R]{
+				Result:     storedResult,
+				Done:       true,
+				Success:    true,
+				SuccessAll: true,
+			}
+		}
+
+		result := innerFn(exec)
+		if result.Error == nil && result.SuccessAll {
+			e.config.store.MarkDone(key, result.Result)
+		}
+		return result
+	}
+}
@@ -0,0 +1,60 @@
+package examples
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+	"github.com/failsafe-go/failsafe-go/retrypolicy"
+)
+
+// observer matches the Observe method of a Prometheus histogram, such as one created via prometheus.NewHistogram.
+type observer interface {
+	Observe(float64)
+}
+
+// recordingObserver is an observer that records its observations, in place of a Prometheus histogram.
+type recordingObserver struct {
+	mtx          sync.Mutex
+	observations []float64
+}
+
+func (o *recordingObserver) Observe(value float64) {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	o.observations = append(o.observations, value)
+}
+
+// This test demonstrates how to record a histogram of the number of attempts that each execution took, such as a
+// Prometheus histogram named failsafe_execution_attempts, by observing the final attempt count from each
+// ExecutionDoneEvent. The distribution of attempts reveals retry amplification under load.
+func TestExecutionAttemptsHistogram(t *testing.T) {
+	// Create an Executor that observes the attempts for each completed execution
+	var attemptsHistogram observer = &recordingObserver{}
+	retryPolicy := retrypolicy.Builder[string]().WithMaxRetries(2).Build()
+	executor := failsafe.NewExecutor[string](retryPolicy).
+		OnDone(func(e failsafe.ExecutionDoneEvent[string]) {
+			attemptsHistogram.Observe(float64(e.Attempts()))
+		})
+
+	// Perform a mix of executions that succeed on the first attempt and that succeed after 2 retries
+	for i := 0; i < 4; i++ {
+		attempts := 0
+		succeedOnAttempt := 1
+		if i%2 == 1 {
+			succeedOnAttempt = 3
+		}
+		executor.Get(func() (string, error) {
+			attempts++
+			if attempts < succeedOnAttempt {
+				return "", testutil.ErrConnecting
+			}
+			return "success", nil
+		})
+	}
+
+	assert.Equal(t, []float64{1, 3, 1, 3}, attemptsHistogram.(*recordingObserver).observations)
+}
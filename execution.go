@@ -2,6 +2,7 @@ package failsafe
 
 import (
 	"context"
+	"log/slog"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -49,6 +50,13 @@ type ExecutionAttempt[R any] interface {
 	// IsRetry returns true when Attempts is > 1, meaning the execution is being retried.
 	IsRetry() bool
 
+	// IsLastAttempt returns true when no more retries will be performed after the current attempt if it fails, because
+	// no RetryPolicy is configured, or because each configured RetryPolicy's max retries have been used, its max duration
+	// has elapsed, or its RetryBudget is exhausted. Since abort conditions and other failure handling depend on the
+	// attempt's result, an attempt that is not the last may still not be retried, such as when it's aborted, a
+	// CircuitBreaker opens, or a RetryBudget that's shared with other executions is exhausted by them in the meantime.
+	IsLastAttempt() bool
+
 	// IsHedge returns true when the execution is part of a hedged attempt.
 	IsHedge() bool
 
@@ -73,6 +81,27 @@ type Execution[R any] interface {
 	// Canceled returns a channel that is closed when the execution is canceled, either by an external Context or a
 	// timeout.Timeout.
 	Canceled() <-chan struct{}
+
+	// SetProgress records the progress, such as the number of bytes transferred, that the execution has completed. This
+	// allows resumable operations, such as large transfers, to resume from the max progress recorded by any previous
+	// attempt, via ResumeFrom, rather than restarting from the beginning when retried. The execution's func must support
+	// resuming from a given progress, such as via a range request, for this to be useful.
+	SetProgress(progress int64)
+
+	// ResumeFrom returns the max progress that was recorded via SetProgress by any attempt of the execution so far, else 0.
+	ResumeFrom() int64
+
+	// SetNextRetryTime sets the time that a RetryPolicy should perform the next retry at, such as a rate limit reset time
+	// provided by a server. When set, this is used as the delay before the next retry in place of any configured delay,
+	// backoff, or jitter, and is bounded by any configured max delay and max duration. The time only applies to the
+	// next retry.
+	SetNextRetryTime(nextRetryTime time.Time)
+
+	// SetIdempotent sets whether the execution is idempotent, which is true by default. A HedgePolicy will not perform
+	// hedges for an execution that is not idempotent, regardless of its configured delay, since duplicate attempts may be
+	// unsafe. This takes precedence over the HedgePolicy's configuration, and applies to any hedges that have not yet been
+	// started, so it should be set before the hedge delay elapses, such as at the start of the execution's func.
+	SetIdempotent(idempotent bool)
 }
 
 // A closed channel that can be used as a canceled channel where the canceled channel would have been closed before it
@@ -86,12 +115,17 @@ func init() {
 
 type execution[R any] struct {
 	// Shared state across instances
-	mtx        *sync.Mutex
-	startTime  time.Time
-	attempts   *atomic.Uint32
-	retries    *atomic.Uint32
-	hedges     *atomic.Uint32
-	executions *atomic.Uint32
+	mtx           *sync.Mutex
+	startTime     time.Time
+	attempts      *atomic.Uint32
+	retries       *atomic.Uint32
+	hedges        *atomic.Uint32
+	executions    *atomic.Uint32
+	progress      *atomic.Int64
+	nonIdempotent *atomic.Bool // Whether the execution was set as not idempotent
+
+	// Shared state across instances, cleared on each retry
+	nextRetryTime *atomic.Int64 // The unix nanos that the next retry should be performed at, else 0
 
 	// Partly shared cancellation state
 	ctx            context.Context
@@ -100,9 +134,18 @@ type execution[R any] struct {
 
 	// Per execution state
 	attemptStartTime time.Time
+	budgetDeadline   time.Time // The deadline that attempts must complete by, if any, such as for a RetryPolicy max duration
+	ctxDeadline      time.Time // The deadline that the ctx will be canceled at, if any, excluding deadlines reported by a deadlineContext
 	isHedge          bool
-	lastResult       R     // The last error that occurred, else the zero value for R.
-	lastError        error // The last error that occurred, else nil.
+	isProbe          bool
+	disabled         *atomic.Bool  // Whether the Toggleable policy currently handling the execution is disabled, if any
+	mayRetry         bool          // Whether a RetryPolicy may retry the current attempt if it fails
+	traceSpan        *TraceSpan[R] // The current span, if the execution is being traced
+	logger           *slog.Logger  // The logger for policies to log to, if any
+	metrics          Metrics       // The metrics for policies to publish to, if any
+	lastResult       R             // The last error that occurred, else the zero value for R.
+	lastError        error         // The last error that occurred, else nil.
+	innerErrors      *innerErrors  // The errors returned within the policy that's currently handling the execution, if any
 }
 
 var _ Execution[any] = &execution[any]{}
@@ -136,6 +179,10 @@ func (e *execution[R]) IsRetry() bool {
 	return e.attempts.Load() > 1
 }
 
+func (e *execution[R]) IsLastAttempt() bool {
+	return !e.mayRetry
+}
+
 func (e *execution[R]) IsHedge() bool {
 	return e.isHedge
 }
@@ -175,6 +222,38 @@ func (e *execution[_]) Canceled() <-chan struct{} {
 	return e.ctx.Done()
 }
 
+func (e *execution[R]) SetProgress(progress int64) {
+	for {
+		current := e.progress.Load()
+		if progress <= current || e.progress.CompareAndSwap(current, progress) {
+			return
+		}
+	}
+}
+
+func (e *execution[R]) ResumeFrom() int64 {
+	return e.progress.Load()
+}
+
+func (e *execution[R]) SetNextRetryTime(nextRetryTime time.Time) {
+	e.nextRetryTime.Store(nextRetryTime.UnixNano())
+}
+
+func (e *execution[R]) SetIdempotent(idempotent bool) {
+	e.nonIdempotent.Store(!idempotent)
+}
+
+func (e *execution[R]) IsIdempotent() bool {
+	return !e.nonIdempotent.Load()
+}
+
+func (e *execution[R]) NextRetryTime() (time.Time, bool) {
+	if nanos := e.nextRetryTime.Load(); nanos != 0 {
+		return time.Unix(0, nanos), true
+	}
+	return time.Time{}, false
+}
+
 func (e *execution[R]) RecordResult(result *common.PolicyResult[R]) *common.PolicyResult[R] {
 	// Lock to guard against a race with a Timeout canceling the execution
 	e.mtx.Lock()
@@ -201,6 +280,7 @@ func (e *execution[R]) InitializeRetry() *common.PolicyResult[R] {
 		e.retries.Add(1)
 	}
 	e.attemptStartTime = time.Now()
+	e.nextRetryTime.Store(0)
 	*e.canceledResult = nil
 	return nil
 }
@@ -258,6 +338,62 @@ func (e *execution[R]) CopyForCancellable() Execution[R] {
 	return c
 }
 
+func (e *execution[R]) CopyForCancellableWithTimeout(timeout time.Duration) Execution[R] {
+	c := e.copy()
+	c.ctx, c.cancelFunc = context.WithCancel(c.ctx)
+	c.ctx = &deadlineContext{
+		Context:  c.ctx,
+		deadline: time.Now().Add(timeout),
+	}
+	return c
+}
+
+func (e *execution[R]) CopyWithContext(ctx context.Context) Execution[R] {
+	c := e.copy()
+	c.ctx = ctx
+	c.cancelFunc = nil
+	c.ctxDeadline, _ = ctx.Deadline()
+	return c
+}
+
+func (e *execution[R]) CopyWithBudgetDeadline(deadline time.Time) Execution[R] {
+	c := e.copy()
+	if c.budgetDeadline.IsZero() || deadline.Before(c.budgetDeadline) {
+		c.budgetDeadline = deadline
+	}
+	return c
+}
+
+func (e *execution[R]) CopyWithRetriesRemaining(retriesRemaining bool) Execution[R] {
+	c := e.copy()
+	c.mayRetry = c.mayRetry || retriesRemaining
+	return c
+}
+
+func (e *execution[R]) BudgetDeadline() (time.Time, bool) {
+	return e.budgetDeadline, !e.budgetDeadline.IsZero()
+}
+
+func (e *execution[R]) ContextDeadline() (time.Time, bool) {
+	return e.ctxDeadline, !e.ctxDeadline.IsZero()
+}
+
+func (e *execution[R]) Logger() *slog.Logger {
+	return e.logger
+}
+
+func (e *execution[R]) Metrics() Metrics {
+	return e.metrics
+}
+
+func (e *execution[R]) IsProbe() bool {
+	return e.isProbe
+}
+
+func (e *execution[R]) IsPolicyDisabled() bool {
+	return e.disabled != nil && e.disabled.Load()
+}
+
 func (e *execution[R]) CopyForHedge() Execution[R] {
 	c := e.copy()
 	c.isHedge = true
@@ -285,15 +421,82 @@ func newExecution[R any](ctx context.Context) *execution[R] {
 	attempts.Add(1)
 	var canceledResult *common.PolicyResult[R]
 	now := time.Now()
+	ctxDeadline, _ := ctx.Deadline()
 	return &execution[R]{
 		ctx:              ctx,
+		ctxDeadline:      ctxDeadline,
 		mtx:              &sync.Mutex{},
 		attempts:         &attempts,
 		retries:          &retries,
 		hedges:           &hedges,
 		executions:       &executions,
+		progress:         &atomic.Int64{},
+		nonIdempotent:    &atomic.Bool{},
+		nextRetryTime:    &atomic.Int64{},
 		canceledResult:   &canceledResult,
 		attemptStartTime: now,
 		startTime:        now,
 	}
 }
+
+// deadlineContext is a context that reports a deadline without being canceled by it. This is used when cancellation at
+// the deadline is performed separately, such as by a Timeout, so that the deadline is still visible to the user's fn.
+type deadlineContext struct {
+	context.Context
+	deadline time.Time
+}
+
+func (c *deadlineContext) Deadline() (time.Time, bool) {
+	if parentDeadline, ok := c.Context.Deadline(); ok && parentDeadline.Before(c.deadline) {
+		return parentDeadline, true
+	}
+	return c.deadline, true
+}
+
+// executionStatsSnapshot is an ExecutionStats that holds a copy of an execution's stats, without referencing the
+// execution.
+type executionStatsSnapshot struct {
+	attempts    int
+	executions  int
+	retries     int
+	hedges      int
+	startTime   time.Time
+	elapsedTime time.Duration
+}
+
+var _ ExecutionStats = &executionStatsSnapshot{}
+
+func newExecutionStatsSnapshot(stats ExecutionStats) *executionStatsSnapshot {
+	return &executionStatsSnapshot{
+		attempts:    stats.Attempts(),
+		executions:  stats.Executions(),
+		retries:     stats.Retries(),
+		hedges:      stats.Hedges(),
+		startTime:   stats.StartTime(),
+		elapsedTime: stats.ElapsedTime(),
+	}
+}
+
+func (s *executionStatsSnapshot) Attempts() int {
+	return s.attempts
+}
+
+func (s *executionStatsSnapshot) Executions() int {
+	return s.executions
+}
+
+func (s *executionStatsSnapshot) Retries() int {
+	return s.retries
+}
+
+func (s *executionStatsSnapshot) Hedges() int {
+	return s.hedges
+}
+
+func (s *executionStatsSnapshot) StartTime() time.Time {
+	return s.startTime
+}
+
+func (s *executionStatsSnapshot) ElapsedTime() time.Duration {
+	return s.elapsedTime
+}
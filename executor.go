@@ -2,10 +2,29 @@ package failsafe
 
 import (
 	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"path"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/failsafe-go/failsafe-go/common"
 )
 
+// ErrIncomplete is returned by an attempt of GetAccumulating that returns without an error, but is not done.
+var ErrIncomplete = errors.New("execution incomplete")
+
+// ErrTooSlow is returned when an execution attempt succeeds but takes longer than the threshold configured via
+// Executor.SuccessIfFast.
+var ErrTooSlow = errors.New("execution too slow")
+
+// ErrListenerTimeout is provided to an OnListenerError listener when an execution's listeners exceed the timeout
+// configured via Executor.WithListenerTimeout.
+var ErrListenerTimeout = errors.New("listener timeout exceeded")
+
 // Run executes the fn, with failures being handled by the policies, until successful or until the policies are exceeded.
 func Run(fn func() error, policies ...Policy[any]) error {
 	return NewExecutor[any](policies...).Run(fn)
@@ -29,6 +48,80 @@ func GetWithExecution[R any](fn func(exec Execution[R]) (R, error), policies ...
 	return NewExecutor[R](policies...).GetWithExecution(fn)
 }
 
+// GetWithContextFunc executes the fn with the executor and ctx, until a successful result is returned or the executor's
+// policies are exceeded. The fn is provided the context for each attempt, which is derived from the ctx. The context is
+// canceled when the ctx is done or when an attempt is canceled, such as by a Timeout or by a HedgePolicy when another
+// attempt completes. For attempts bounded by a Timeout, the context's Deadline reports the attempt's deadline.
+func GetWithContextFunc[R any](executor Executor[R], ctx context.Context, fn func(context.Context) (R, error)) (R, error) {
+	return executor.WithContext(ctx).GetWithExecution(func(exec Execution[R]) (R, error) {
+		return fn(exec.Context())
+	})
+}
+
+// GetAccumulating executes the fn with the executor, accumulating the results of attempts, such as the parts of a
+// multi-step operation, until an attempt is done or the executor's policies are exceeded. Each attempt is provided the
+// latest accumulator, starting with the initial accumulator, and returns an updated accumulator, whether the operation
+// is done, and an error. The updated accumulator is retained even when an attempt returns an error, so that the next
+// attempt resumes from the progress made so far.
+//
+// An attempt that returns a nil error but is not done fails with ErrIncomplete, so that the executor's policies, such as
+// a RetryPolicy, perform another attempt. As a result, each attempt counts toward a RetryPolicy's max attempts,
+// including attempts that make progress without failing, so the max attempts should allow for the number of attempts
+// needed to complete the operation. When an execution fails, the latest accumulator is returned along with the error.
+// Concurrent attempts, such as hedges, share the accumulator.
+func GetAccumulating[A any](executor Executor[A], initial A, fn func(exec Execution[A], acc A) (A, bool, error)) (A, error) {
+	var mtx sync.Mutex
+	acc := initial
+	result, err := executor.GetWithExecution(func(exec Execution[A]) (A, error) {
+		mtx.Lock()
+		current := acc
+		mtx.Unlock()
+
+		next, done, err := fn(exec, current)
+		mtx.Lock()
+		acc = next
+		mtx.Unlock()
+		if err == nil && !done {
+			err = ErrIncomplete
+		}
+		return next, err
+	})
+	if err != nil {
+		mtx.Lock()
+		defer mtx.Unlock()
+		return acc, err
+	}
+	return result, nil
+}
+
+// Supervise executes the fn with the executor and ctx, and restarts it whenever it fails after the executor's policies are
+// exceeded, until the fn succeeds or the ctx is done. This is useful for long-running tasks that should be kept running,
+// such as workers. Restarts are performed immediately, so delays between failed attempts should be configured via a
+// RetryPolicy in the executor, which then controls how many times the fn is retried before being restarted. If the fn
+// succeeds, its result is returned. If the ctx is done, the error from the last failed execution is returned, or the
+// ctx's error if no execution failed.
+//
+// If the executor includes a CircuitBreaker, executions that are rejected while the breaker is open fail immediately,
+// which also causes a restart. To avoid restarting in a tight loop while the breaker is open, compose a RetryPolicy with
+// a delay outside of the CircuitBreaker, so that rejections are retried with a delay before a restart occurs.
+func Supervise[R any](ctx context.Context, executor Executor[R], fn func(exec Execution[R]) (R, error)) (R, error) {
+	executor = executor.WithContext(ctx)
+	var lastErr error
+	for {
+		result, err := executor.GetWithExecution(fn)
+		if err == nil {
+			return result, nil
+		}
+		if ctx.Err() != nil {
+			if lastErr == nil {
+				lastErr = ctx.Err()
+			}
+			return result, lastErr
+		}
+		lastErr = err
+	}
+}
+
 // RunAsync executes the fn in a goroutine, with failures being handled by the policies, until successful or until the
 // policies are exceeded.
 func RunAsync(fn func() error, policies ...Policy[any]) ExecutionResult[any] {
@@ -53,6 +146,9 @@ func GetWithExecutionAsync[R any](fn func(exec Execution[R]) (R, error), policie
 	return NewExecutor[R](policies...).GetWithExecutionAsync(fn)
 }
 
+// ExecFn is a func that performs an execution and returns its result. See Executor.WithInterceptor.
+type ExecFn[R any] func(exec Execution[R]) (R, error)
+
 // Executor handles failures according to configured policies. See [NewExecutor] for details.
 //
 // This type is concurrency safe.
@@ -62,69 +158,288 @@ type Executor[R any] interface {
 	// Execution.Canceled or Execution.IsCanceled.
 	WithContext(ctx context.Context) Executor[R]
 
-	// OnDone registers the listener to be called when an execution is done.
+	// WithFailureResult returns a new copy of the Executor that returns the result, rather than the zero value for R or
+	// whatever result the failed attempt returned, when an execution fails with an error. This is useful for types where
+	// the zero value is ambiguous. If a Fallback provides a result for the failed execution, that result is returned
+	// instead, even if the Fallback also returns an error.
+	WithFailureResult(result R) Executor[R]
+
+	// WithListenerTimeout returns a new copy of the Executor that bounds how long the OnDone, OnSuccess, and OnFailure
+	// listeners may run after an execution is done. Listeners that exceed the listenerTimeout are abandoned, allowing the
+	// execution's result to be returned, while the listeners continue to run in a separate goroutine. This protects callers
+	// from listeners that hang. Since the listeners run in a separate goroutine, any panic in them is recovered. Listeners
+	// that time out or panic are reported to any OnListenerError listener, as ErrListenerTimeout or a PanicError, and are
+	// logged as a warning to any logger configured via WithLoggerFunc.
+	WithListenerTimeout(listenerTimeout time.Duration) Executor[R]
+
+	// WithOverheadTracking returns a new copy of the Executor that tracks the time spent in policies, excluding the time
+	// spent in the execution's func, and provides it via ExecutionDoneEvent.OverheadTime. This is useful for deciding
+	// whether to use policies for performance sensitive calls. The overhead includes any delays performed by policies,
+	// such as retry delays or waiting for a permit. Tracking is disabled by default since it adds its own overhead.
+	WithOverheadTracking() Executor[R]
+
+	// WithTerminalPolicyTracking returns a new copy of the Executor that tracks which policy produced an execution's final
+	// failure, and provides it via ExecutionDoneEvent.TerminalPolicyIndex and TerminalPolicyType. Tracking is disabled by
+	// default since it adds overhead to each policy's execution.
+	WithTerminalPolicyTracking() Executor[R]
+
+	// WithDebugWriter returns a new copy of the Executor that writes human-readable lines to the writer describing each
+	// execution, including when it starts, each attempt and its outcome, retry delays, policy rejections, and when it's
+	// done. This is intended for quick local debugging, not for production use. Writes are best effort, and any write
+	// errors are ignored, but writes are performed synchronously, so a slow writer will slow down executions.
+	WithDebugWriter(w io.Writer) Executor[R]
+
+	// SuccessIfFast returns a new copy of the Executor that treats execution attempts which return a nil error, but take
+	// longer than the threshold, as failures, by replacing their error with ErrTooSlow while retaining their result. This
+	// allows slow executions to be handled by policies, such as a Fallback, and to trigger OnFailure listeners. This
+	// classifies results at the level of each attempt of the executor's func, and does not otherwise affect how policies
+	// behave.
+	SuccessIfFast(threshold time.Duration) Executor[R]
+
+	// WithoutResultRetention returns a new copy of the Executor that does not retain execution results in the
+	// ExecutionDoneEvent provided to the OnDone, OnSuccess, and OnFailure listeners. Listeners then receive the zero value
+	// for R as the event's Result, along with the event's Error, and stats that do not reference the execution. This is
+	// useful for avoiding pinning large results in memory when listeners retain events, such as when handling them
+	// asynchronously. The result is still returned to the caller.
+	WithoutResultRetention() Executor[R]
+
+	// WithAttemptResults returns a new copy of the Executor that records the outcome of each execution attempt and
+	// provides them via ExecutionDoneEvent.AttemptResults. This is useful for debugging flaky operations. Recording is
+	// disabled by default since it adds its own overhead.
+	WithAttemptResults() Executor[R]
+
+	// WithCancellationAsComplete returns a new copy of the Executor that treats executions which fail because their
+	// context was canceled, such as by the caller, as neither successful nor failed. For these executions, only the OnDone
+	// listener is called, and the OnSuccess and OnFailure listeners are not. This is useful for keeping expected
+	// cancellations out of failure metrics. By default, canceled executions are treated as failures.
+	WithCancellationAsComplete() Executor[R]
+
+	// WithLoggerFunc returns a new copy of the Executor that calls the loggerFunc at the start of each execution to obtain
+	// a logger, such as one with fields from a request's context, that policies log their internal events to. Events are
+	// logged at the debug level, with a "policy" field for the policy type and an "attempt" field for the execution
+	// attempt. The following events are logged:
+	//
+	//   - RetryPolicy: retry scheduled, retries exceeded, and retries aborted
+	//   - CircuitBreaker: state changed, when caused by an execution
+	//   - Timeout: timeout exceeded
+	//   - HedgePolicy: hedge started
+	//   - Fallback: fallback executed
+	//
+	// If the loggerFunc returns nil, nothing is logged for the execution.
+	WithLoggerFunc(loggerFunc func(exec Execution[R]) *slog.Logger) Executor[R]
+
+	// WithMetrics returns a new copy of the Executor that publishes metrics for executions and their policies to the
+	// metrics. The following metrics are published:
+	//
+	//   - Executor: MetricExecutions, MetricExecutionDuration, and MetricExecutionAttempts
+	//   - RetryPolicy: MetricRetries
+	//   - HedgePolicy: MetricHedges
+	//   - CircuitBreaker: MetricRejections, and MetricCircuitBreakerTransitions and MetricCircuitBreakerState when caused
+	//     by an execution
+	//   - Timeout: MetricTimeoutsExceeded
+	//   - RateLimiter, Bulkhead, and AdaptiveLimiter: MetricRejections
+	//
+	// If the metrics implement ContextMetrics, each execution's metrics are published via the Metrics that's bound to the
+	// execution's context.
+	WithMetrics(metrics Metrics) Executor[R]
+
+	// WithTracer returns a new copy of the Executor that traces executions via the tracer. A SpanExecution span is started
+	// for each execution, as a child of any span in the Executor's context, along with a SpanAttempt child span for each
+	// attempt of the execution's func. Policy outcomes are recorded as events on the execution's span, including retries
+	// being scheduled, hedges being started, timeouts being exceeded, circuit breaker state changes, and rejections. When
+	// the func is provided an Execution, its Context contains the attempt's span.
+	WithTracer(tracer Tracer) Executor[R]
+
+	// WithInterceptor returns a new copy of the Executor that wraps the composed policies and func with the interceptor,
+	// which is useful for cross-cutting concerns such as logging, refreshing credentials, or translating errors, without
+	// implementing a Policy. The interceptor is provided the next ExecFn, which performs the execution via the policies,
+	// and returns an ExecFn that should call next with the Execution it's provided. Interceptors may call next multiple
+	// times, such as to perform the execution again after refreshing credentials, in which case the policies are applied
+	// anew each time, and each call after the first counts as a retry in the execution's stats. The result and error that
+	// the interceptor returns are the execution's result and error, which are provided to any listeners.
+	//
+	// Multiple interceptors can be added, where the first interceptor is the outermost.
+	WithInterceptor(interceptor func(next ExecFn[R]) ExecFn[R]) Executor[R]
+
+	// WithPanicsAsErrors returns a new copy of the Executor that recovers panics in the execution's func and converts them
+	// into a PanicError, which wraps ErrPanic along with the panic value and stack trace. The PanicError is then handled
+	// by policies and listeners like any other error. Since policies handle any error by default, a RetryPolicy will retry
+	// panics unless it's configured otherwise, such as via AbortOnErrors(failsafe.ErrPanic). By default, panics are not
+	// recovered.
+	WithPanicsAsErrors() Executor[R]
+
+	// ReplacePolicies atomically replaces the policies that the Executor composes around a func with the policies, in the
+	// same order as NewExecutor. Subsequent executions use the new policies, while executions that are already in progress
+	// continue using the previous policies until they're done. Copies of the Executor created via its With and On methods
+	// have their own policies, so replacing the policies of a copy does not affect the original, and vice versa. This is
+	// useful for reloading policy configuration at runtime.
+	//
+	// Stateful policies, such as CircuitBreakers, RateLimiters, and Bulkheads, are replaced wholesale along with their
+	// state. To retain a stateful policy's state, include the same policy instance in the new policies.
+	ReplacePolicies(policies []Policy[R])
+
+	// ConfigJSON returns a JSON snapshot of the Executor's configuration, which is useful for debugging. The snapshot
+	// contains a "policies" array with an entry for each policy, from outermost to innermost, with the policy's "type",
+	// such as "retrypolicy", and its "config" settings, such as a RetryPolicy's max retries and delays, or a Timeout's time
+	// limit. Durations are formatted as strings. Listeners and funcs are not included, and policies that do not describe
+	// their configuration, such as custom policies, only include their type. Runtime state, such as a CircuitBreaker's
+	// state, is not included, and is available via each policy's metrics.
+	ConfigJSON() ([]byte, error)
+
+	// OnDone returns a new copy of the Executor with the listener registered to be called when an execution is done. The
+	// Executor that OnDone is called on is not modified, so that Executors can be shared and derived from safely.
 	OnDone(listener func(ExecutionDoneEvent[R])) Executor[R]
 
-	// OnSuccess registers the listener to be called when an execution is successful. If multiple policies, are configured,
+	// OnSuccess returns a new copy of the Executor with the listener registered to be called when an execution is
+	// successful. The Executor that OnSuccess is called on is not modified. If multiple policies, are configured,
 	// this handler is called when execution is done and all policies succeed. If all policies do not succeed, then the
-	// OnFailure registered listener is called instead.
+	// OnFailure registered listener is called instead. A Fallback that recovers from a failure, by returning a result that
+	// it does not consider a failure, is considered successful, so this handler is called.
 	OnSuccess(listener func(ExecutionDoneEvent[R])) Executor[R]
 
-	// OnFailure registers the listener to be called when an execution fails. This occurs when the execution fails according
-	// to some policy, and all policies have been exceeded.
+	// OnFailure returns a new copy of the Executor with the listener registered to be called when an execution fails. The
+	// Executor that OnFailure is called on is not modified. This occurs when the execution fails according to some policy,
+	// and all policies have been exceeded. This is not called when a Fallback recovers from a failure.
 	OnFailure(listener func(ExecutionDoneEvent[R])) Executor[R]
 
+	// OnListenerError returns a new copy of the Executor with the listener registered to be called with an error when the
+	// OnDone, OnSuccess, or OnFailure listeners exceed the timeout configured via WithListenerTimeout, in which case the
+	// error is ErrListenerTimeout, or when they panic while a listener timeout is configured, in which case the error is a
+	// PanicError. The Executor that OnListenerError is called on is not modified. The listener may be called from a
+	// separate goroutine.
+	OnListenerError(listener func(err error)) Executor[R]
+
 	// Run executes the fn until successful or until the configured policies are exceeded.
 	//
-	// Any panic causes the execution to stop immediately without calling any event listeners.
+	// Any panic causes the execution to stop immediately without calling any event listeners, unless the Executor is
+	// configured with WithPanicsAsErrors.
 	Run(fn func() error) error
 
 	// RunWithExecution executes the fn until successful or until the configured policies are exceeded, while providing an
 	// Execution to the fn.
 	//
-	// Any panic causes the execution to stop immediately without calling any event listeners.
+	// Any panic causes the execution to stop immediately without calling any event listeners, unless the Executor is
+	// configured with WithPanicsAsErrors.
 	RunWithExecution(fn func(exec Execution[R]) error) error
 
+	// RunWithContext executes the fn until successful or until the configured policies are exceeded, while providing the
+	// context for each attempt to the fn. The context is derived from any context configured via WithContext, and is
+	// canceled when an attempt is canceled, such as by a Timeout. For attempts bounded by a Timeout, the context's Deadline
+	// reports the attempt's deadline.
+	//
+	// Any panic causes the execution to stop immediately without calling any event listeners, unless the Executor is
+	// configured with WithPanicsAsErrors.
+	RunWithContext(fn func(ctx context.Context) error) error
+
+	// RunWithResult executes the fn until successful or until the configured policies are exceeded, and returns an
+	// ExecutionDoneEvent describing the completed execution, including its error, attempts, duration, and any policy that
+	// produced the final failure. This is useful for logging stats about executions whose result is irrelevant. The
+	// event's Result is the zero value for R unless a policy, such as a Fallback, provides one.
+	//
+	// Any panic causes the execution to stop immediately without calling any event listeners, unless the Executor is
+	// configured with WithPanicsAsErrors.
+	RunWithResult(fn func() error) ExecutionDoneEvent[R]
+
 	// Get executes the fn until a successful result is returned or the configured policies are exceeded.
 	//
-	// Any panic causes the execution to stop immediately without calling any event listeners.
+	// Any panic causes the execution to stop immediately without calling any event listeners, unless the Executor is
+	// configured with WithPanicsAsErrors.
 	Get(fn func() (R, error)) (R, error)
 
 	// GetWithExecution executes the fn until a successful result is returned or the configured policies are exceeded, while
 	// providing an Execution to the fn.
 	//
-	// Any panic causes the execution to stop immediately without calling any event listeners.
+	// Any panic causes the execution to stop immediately without calling any event listeners, unless the Executor is
+	// configured with WithPanicsAsErrors.
 	GetWithExecution(fn func(exec Execution[R]) (R, error)) (R, error)
 
+	// GetWithContext executes the fn until a successful result is returned or the configured policies are exceeded, while
+	// providing the context for each attempt to the fn. The context is derived from any context configured via
+	// WithContext, and is canceled when an attempt is canceled, such as by a Timeout. For attempts bounded by a Timeout,
+	// the context's Deadline reports the attempt's deadline.
+	//
+	// Any panic causes the execution to stop immediately without calling any event listeners, unless the Executor is
+	// configured with WithPanicsAsErrors.
+	GetWithContext(fn func(ctx context.Context) (R, error)) (R, error)
+
+	// GetWithTrace executes the fn until a successful result is returned or the configured policies are exceeded, and
+	// returns an ExecutionTrace describing how the execution traversed the policies, including each policy's calls to the
+	// policies or func that it's composed around, their timing, delays, and results. This is intended for debugging, and
+	// adds overhead to the execution.
+	//
+	// Any panic causes the execution to stop immediately without calling any event listeners, unless the Executor is
+	// configured with WithPanicsAsErrors.
+	GetWithTrace(fn func() (R, error)) (R, error, ExecutionTrace[R])
+
+	// Probe executes the fn as a probe, such as for active health checking, until a successful result is returned or the
+	// configured policies are exceeded. Probes are handled by the configured policies, but do not affect the state of
+	// policies that track executions. CircuitBreakers do not record probe results and permit probes regardless of their
+	// state, while RateLimiters and AdaptiveRateLimiters do not consume permits for or adapt to probes. Other policies,
+	// including Timeouts, RetryPolicies, Bulkheads, and Fallbacks, handle probes as usual.
+	//
+	// Any panic causes the execution to stop immediately without calling any event listeners, unless the Executor is
+	// configured with WithPanicsAsErrors.
+	Probe(fn func() (R, error)) (R, error)
+
+	// GetToChannel repeatedly executes the fn, with each execution handled by the configured policies, and sends each
+	// successful result to the out channel. Sends block until the result is received, providing back-pressure to the
+	// fn. Production stops when the fn returns true to signal that it's done, in which case the accompanying result is
+	// not sent and nil is returned, when an execution fails after the configured policies are exceeded, in which case the
+	// error is returned, or when the Executor's context is done, in which case the context's error is returned. The out
+	// channel is not closed by GetToChannel.
+	//
+	// Any panic causes the execution to stop immediately without calling any event listeners, unless the Executor is
+	// configured with WithPanicsAsErrors.
+	GetToChannel(fn func() (R, bool, error), out chan<- R) error
+
 	// RunAsync executes the fn in a goroutine until successful or until the configured policies are exceeded.
 	//
-	// Any panic causes the execution to stop immediately without calling any event listeners.
+	// Any panic causes the execution to stop immediately without calling any event listeners, unless the Executor is
+	// configured with WithPanicsAsErrors.
 	RunAsync(fn func() error) ExecutionResult[R]
 
 	// RunWithExecutionAsync executes the fn in a goroutine until successful or until the configured policies are exceeded,
 	// while providing an Execution to the fn.
 	//
-	// Any panic causes the execution to stop immediately without calling any event listeners.
+	// Any panic causes the execution to stop immediately without calling any event listeners, unless the Executor is
+	// configured with WithPanicsAsErrors.
 	RunWithExecutionAsync(fn func(exec Execution[R]) error) ExecutionResult[R]
 
 	// GetAsync executes the fn in a goroutine until a successful result is returned or the configured policies are exceeded.
 	//
-	// Any panic causes the execution to stop immediately without calling any event listeners.
+	// Any panic causes the execution to stop immediately without calling any event listeners, unless the Executor is
+	// configured with WithPanicsAsErrors.
 	GetAsync(fn func() (R, error)) ExecutionResult[R]
 
 	// GetWithExecutionAsync executes the fn in a goroutine until a successful result is returned or the configured policies
 	// are exceeded, while providing an Execution to the fn.
 	//
-	// Any panic causes the execution to stop immediately without calling any event listeners.
+	// Any panic causes the execution to stop immediately without calling any event listeners, unless the Executor is
+	// configured with WithPanicsAsErrors.
 	GetWithExecutionAsync(fn func(exec Execution[R]) (R, error)) ExecutionResult[R]
 }
 
 type executor[R any] struct {
-	policies  []Policy[R]
-	ctx       context.Context
-	onDone    func(ExecutionDoneEvent[R])
-	onSuccess func(ExecutionDoneEvent[R])
-	onFailure func(ExecutionDoneEvent[R])
+	policies        *atomic.Pointer[[]Policy[R]]
+	ctx             context.Context
+	failureResult   *R
+	listenerTimeout time.Duration
+	trackOverhead   bool
+	trackTerminal   bool
+	debugWriter     *debugWriter
+	slowThreshold   time.Duration
+	discardResults  bool
+	recordAttempts  bool
+	cancelAsDone    bool
+	loggerFunc      func(Execution[R]) *slog.Logger
+	metrics         Metrics
+	tracer          Tracer
+	interceptors    []func(ExecFn[R]) ExecFn[R]
+	recoverPanics   bool
+	onDone          func(ExecutionDoneEvent[R])
+	onSuccess       func(ExecutionDoneEvent[R])
+	onFailure       func(ExecutionDoneEvent[R])
+	onListenerError func(error)
 }
 
 // NewExecutor creates and returns a new Executor for result type R that will handle failures according to the given
@@ -136,33 +451,159 @@ type executor[R any] struct {
 //
 //	Fallback(RetryPolicy(CircuitBreaker(func)))
 func NewExecutor[R any](policies ...Policy[R]) Executor[R] {
+	policiesRef := &atomic.Pointer[[]Policy[R]]{}
+	policiesRef.Store(&policies)
 	return &executor[R]{
-		policies: policies,
+		policies: policiesRef,
 		ctx:      context.Background(),
 	}
 }
 
-func (e *executor[R]) WithContext(ctx context.Context) Executor[R] {
+// WithInnerFirst creates and returns a new Executor for result type R that will handle failures according to the given
+// policies, where the policies are provided from the inside out, starting with the innermost policy that's closest to the
+// func. This is the opposite order of NewExecutor. For example, consider:
+//
+//	failsafe.WithInnerFirst(circuitBreaker, retryPolicy, fallback).Get(fn)
+//
+// This creates the same composition as NewExecutor(fallback, retryPolicy, circuitBreaker):
+//
+//	Fallback(RetryPolicy(CircuitBreaker(func)))
+func WithInnerFirst[R any](innermost Policy[R], policies ...Policy[R]) Executor[R] {
+	outerFirst := make([]Policy[R], 0, len(policies)+1)
+	for i := len(policies) - 1; i >= 0; i-- {
+		outerFirst = append(outerFirst, policies[i])
+	}
+	return NewExecutor[R](append(outerFirst, innermost)...)
+}
+
+// copy returns a copy of the executor with its own reference to the current policies, so that replacing the policies
+// of the copy does not affect the original, and vice versa.
+func (e *executor[R]) copy() *executor[R] {
 	c := *e
+	c.policies = &atomic.Pointer[[]Policy[R]]{}
+	c.policies.Store(e.policies.Load())
+	return &c
+}
+
+func (e *executor[R]) WithContext(ctx context.Context) Executor[R] {
+	c := e.copy()
 	if ctx != nil {
 		c.ctx = ctx
 	}
-	return &c
+	return c
+}
+
+func (e *executor[R]) WithFailureResult(result R) Executor[R] {
+	c := e.copy()
+	c.failureResult = &result
+	return c
+}
+
+func (e *executor[R]) WithListenerTimeout(listenerTimeout time.Duration) Executor[R] {
+	c := e.copy()
+	c.listenerTimeout = listenerTimeout
+	return c
+}
+
+func (e *executor[R]) WithOverheadTracking() Executor[R] {
+	c := e.copy()
+	c.trackOverhead = true
+	return c
+}
+
+func (e *executor[R]) WithTerminalPolicyTracking() Executor[R] {
+	c := e.copy()
+	c.trackTerminal = true
+	return c
+}
+
+func (e *executor[R]) WithDebugWriter(w io.Writer) Executor[R] {
+	c := e.copy()
+	c.debugWriter = &debugWriter{w: w}
+	return c
+}
+
+func (e *executor[R]) SuccessIfFast(threshold time.Duration) Executor[R] {
+	c := e.copy()
+	c.slowThreshold = threshold
+	return c
+}
+
+func (e *executor[R]) WithoutResultRetention() Executor[R] {
+	c := e.copy()
+	c.discardResults = true
+	return c
+}
+
+func (e *executor[R]) WithAttemptResults() Executor[R] {
+	c := e.copy()
+	c.recordAttempts = true
+	return c
+}
+
+func (e *executor[R]) WithCancellationAsComplete() Executor[R] {
+	c := e.copy()
+	c.cancelAsDone = true
+	return c
+}
+
+func (e *executor[R]) WithLoggerFunc(loggerFunc func(exec Execution[R]) *slog.Logger) Executor[R] {
+	c := e.copy()
+	c.loggerFunc = loggerFunc
+	return c
+}
+
+func (e *executor[R]) WithMetrics(metrics Metrics) Executor[R] {
+	c := e.copy()
+	c.metrics = metrics
+	return c
+}
+
+func (e *executor[R]) WithTracer(tracer Tracer) Executor[R] {
+	c := e.copy()
+	c.tracer = tracer
+	return c
+}
+
+func (e *executor[R]) WithInterceptor(interceptor func(next ExecFn[R]) ExecFn[R]) Executor[R] {
+	c := e.copy()
+	c.interceptors = append(append([]func(ExecFn[R]) ExecFn[R](nil), e.interceptors...), interceptor)
+	return c
+}
+
+func (e *executor[R]) WithPanicsAsErrors() Executor[R] {
+	c := e.copy()
+	c.recoverPanics = true
+	return c
+}
+
+func (e *executor[R]) ReplacePolicies(policies []Policy[R]) {
+	policies = append([]Policy[R](nil), policies...)
+	e.policies.Store(&policies)
 }
 
 func (e *executor[R]) OnDone(listener func(ExecutionDoneEvent[R])) Executor[R] {
-	e.onDone = listener
-	return e
+	c := e.copy()
+	c.onDone = listener
+	return c
 }
 
 func (e *executor[R]) OnSuccess(listener func(ExecutionDoneEvent[R])) Executor[R] {
-	e.onSuccess = listener
-	return e
+	c := e.copy()
+	c.onSuccess = listener
+	return c
 }
 
 func (e *executor[R]) OnFailure(listener func(ExecutionDoneEvent[R])) Executor[R] {
-	e.onFailure = listener
-	return e
+	c := e.copy()
+	c.onFailure = listener
+	return c
+}
+
+func (e *executor[R]) OnListenerError(listener func(err error)) Executor[R] {
+	c := e.copy()
+	c.onListenerError = listener
+	return c
 }
 
 func (e *executor[R]) Run(fn func() error) error {
@@ -179,6 +620,21 @@ func (e *executor[R]) RunWithExecution(fn func(exec Execution[R]) error) error {
 	return err
 }
 
+func (e *executor[R]) RunWithContext(fn func(ctx context.Context) error) error {
+	_, err := e.executeSync(func(exec Execution[R]) (R, error) {
+		return *(new(R)), fn(exec.Context())
+	}, true)
+	return err
+}
+
+func (e *executor[R]) RunWithResult(fn func() error) ExecutionDoneEvent[R] {
+	var event ExecutionDoneEvent[R]
+	e.execute(func(_ Execution[R]) (R, error) {
+		return *(new(R)), fn()
+	}, newExecution[R](e.ctx), false, &event)
+	return event
+}
+
 func (e *executor[R]) Get(fn func() (R, error)) (R, error) {
 	return e.executeSync(func(_ Execution[R]) (R, error) {
 		return fn()
@@ -191,6 +647,74 @@ func (e *executor[R]) GetWithExecution(fn func(exec Execution[R]) (R, error)) (R
 	}, true)
 }
 
+func (e *executor[R]) GetWithContext(fn func(ctx context.Context) (R, error)) (R, error) {
+	return e.executeSync(func(exec Execution[R]) (R, error) {
+		return fn(exec.Context())
+	}, true)
+}
+
+func (e *executor[R]) GetWithTrace(fn func() (R, error)) (R, error, ExecutionTrace[R]) {
+	exec := newExecution[R](e.ctx)
+	exec.traceSpan = newTraceSpan[R]()
+	er := e.execute(func(_ Execution[R]) (R, error) {
+		return fn()
+	}, exec, false, nil)
+	exec.traceSpan.end(nil)
+	return er.Result, er.Error, ExecutionTrace[R]{
+		StartTime: exec.traceSpan.StartTime,
+		Duration:  exec.traceSpan.Duration,
+		Spans:     exec.traceSpan.Children,
+	}
+}
+
+func (e *executor[R]) Probe(fn func() (R, error)) (R, error) {
+	exec := newExecution[R](e.ctx)
+	exec.isProbe = true
+	er := e.execute(func(_ Execution[R]) (R, error) {
+		return fn()
+	}, exec, false, nil)
+	return er.Result, er.Error
+}
+
+func (e *executor[R]) GetToChannel(fn func() (R, bool, error), out chan<- R) error {
+	return e.produce(fn, func(result R) bool {
+		select {
+		case out <- result:
+			return true
+		case <-e.ctx.Done():
+			return false
+		}
+	})
+}
+
+// produce repeatedly executes the fn and passes each successful result to the emit func, until the fn is done, an
+// execution fails, the context is done, or the emit func returns false. Returns any execution or context error.
+func (e *executor[R]) produce(fn func() (R, bool, error), emit func(R) bool) error {
+	for {
+		if err := e.ctx.Err(); err != nil {
+			return err
+		}
+
+		var done atomic.Bool
+		result, err := e.Get(func() (R, error) {
+			result, isDone, err := fn()
+			if err == nil && isDone {
+				done.Store(true)
+			}
+			return result, err
+		})
+		if err != nil {
+			return err
+		}
+		if done.Load() {
+			return nil
+		}
+		if !emit(result) {
+			return e.ctx.Err()
+		}
+	}
+}
+
 func (e *executor[R]) RunAsync(fn func() error) ExecutionResult[R] {
 	return e.executeAsync(func(_ Execution[R]) (R, error) {
 		return *(new(R)), fn()
@@ -221,7 +745,7 @@ type policyExecutor[R any] interface {
 }
 
 func (e *executor[R]) executeSync(fn func(exec Execution[R]) (R, error), withExec bool) (R, error) {
-	er := e.execute(fn, newExecution[R](e.ctx), withExec)
+	er := e.execute(fn, newExecution[R](e.ctx), withExec, nil)
 	return er.Result, er.Error
 }
 
@@ -238,46 +762,333 @@ func (e *executor[R]) executeAsync(fn func(exec Execution[R]) (R, error), withEx
 		doneChan:   make(chan any, 1),
 	}
 	go func() {
-		result.record(e.execute(fn, exec, withExec))
+		result.record(e.execute(fn, exec, withExec, nil))
 	}()
 	return result
 }
 
-func (e *executor[R]) execute(fn func(exec Execution[R]) (R, error), outerExec *execution[R], withExec bool) *common.PolicyResult[R] {
+// execute executes the fn with the policies and returns the result. If doneEvent is not nil, it's populated with the
+// ExecutionDoneEvent for the execution.
+func (e *executor[R]) execute(fn func(exec Execution[R]) (R, error), outerExec *execution[R], withExec bool, doneEvent *ExecutionDoneEvent[R]) *common.PolicyResult[R] {
+	// The policies that produced failures, if they're being tracked
+	var terminalErrs terminalErrors
+	// The policies to compose, which are loaded once so that the execution is unaffected by ReplacePolicies
+	policies := *e.policies.Load()
+	// The total time spent in the fn, if overhead is being tracked
+	var fnTime atomic.Int64
+	// The results of each attempt, if they're being recorded
+	var attempts *attemptResults[R]
+	if e.recordAttempts {
+		attempts = newAttemptResults[R]()
+	}
+	if e.loggerFunc != nil {
+		outerExec.logger = e.loggerFunc(outerExec)
+	}
+	var span Span
+	if e.tracer != nil {
+		outerExec.ctx, span = e.tracer.StartSpan(outerExec.ctx, SpanExecution)
+	}
+	metrics := e.metrics
+	if contextMetrics, ok := metrics.(ContextMetrics); ok {
+		metrics = contextMetrics.WithContext(outerExec.ctx)
+	}
+	outerExec.metrics = metrics
+	if span != nil {
+		outerExec.metrics = &spanMetrics{metrics: metrics, span: span}
+	}
+	var debugger *executionDebugger
+	if e.debugWriter != nil {
+		debugger = &executionDebugger{debugWriter: e.debugWriter}
+		debugger.printf("execution started")
+	}
 	outerFn := func(exec Execution[R]) *common.PolicyResult[R] {
 		execInternal := exec.(*execution[R])
+		var attemptCtx context.Context
+		var attemptSpan Span
+		if e.tracer != nil {
+			attemptCtx, attemptSpan = e.tracer.StartSpan(execInternal.Context(), SpanAttempt)
+			attemptSpan.SetAttribute("failsafe.attempt", execInternal.Attempts())
+			attemptSpan.SetAttribute("failsafe.retry", execInternal.IsRetry())
+			attemptSpan.SetAttribute("failsafe.hedge", execInternal.IsHedge())
+		}
 		var execForUser Execution[R]
 		if withExec {
 			// Only copy and provide an execution to the user fn if needed
-			execForUser = execInternal.copy()
+			c := execInternal.copy()
+			if attemptCtx != nil {
+				c.ctx = attemptCtx
+			}
+			execForUser = c
+		}
+		var span *TraceSpan[R]
+		if execInternal.traceSpan != nil {
+			span = execInternal.traceSpan.startChild("", -1)
+		}
+		var fnStartTime time.Time
+		if e.trackOverhead || debugger != nil || e.slowThreshold > 0 || attempts != nil {
+			fnStartTime = time.Now()
+		}
+		attempt := execInternal.Attempts()
+		if debugger != nil {
+			debugger.attemptStarted(attempt, execInternal.IsRetry(), execInternal.IsHedge())
+		}
+		var result R
+		var err error
+		if e.recoverPanics {
+			result, err = callRecovering(fn, execForUser)
+		} else {
+			result, err = fn(execForUser)
+		}
+		if e.trackOverhead {
+			fnTime.Add(int64(time.Since(fnStartTime)))
+		}
+		tooSlow := e.slowThreshold > 0 && err == nil && time.Since(fnStartTime) > e.slowThreshold
+		if tooSlow {
+			err = ErrTooSlow
+		}
+		if debugger != nil {
+			debugger.attemptDone(attempt, time.Since(fnStartTime), err)
+		}
+		if attemptSpan != nil {
+			attemptSpan.End(err)
+		}
+		if attempts != nil {
+			attempts.recordFnResult(attempt, result, err, fnStartTime)
 		}
-		result, err := fn(execForUser)
 		execInternal.record()
-		return &common.PolicyResult[R]{
+		er := &common.PolicyResult[R]{
 			Result:     result,
 			Error:      err,
 			Done:       true,
-			Success:    true,
-			SuccessAll: true,
+			Success:    !tooSlow,
+			SuccessAll: !tooSlow,
+		}
+		if span != nil {
+			span.end(er)
 		}
+		return er
 	}
 
 	// Compose policy executors from the innermost policy to the outermost
-	for i := len(e.policies) - 1; i >= 0; i-- {
-		pe := e.policies[i].ToExecutor(*(new(R))).(policyExecutor[R])
-		outerFn = pe.Apply(outerFn)
+	compose := func() func(Execution[R]) *common.PolicyResult[R] {
+		composedFn := outerFn
+		for i := len(policies) - 1; i >= 0; i-- {
+			pe := policies[i].ToExecutor(*(new(R))).(policyExecutor[R])
+			if e.trackTerminal || debugger != nil || attempts != nil {
+				composedFn = applyWithTerminalTracking(pe, i, composedFn, &terminalErrs, debugger, attempts, policies[i])
+			} else {
+				composedFn = pe.Apply(composedFn)
+			}
+			if outerExec.traceSpan != nil {
+				composedFn = applyWithTrace(policyType(policies[i]), i, composedFn)
+			}
+		}
+		return composedFn
 	}
 
 	// Execute
-	er := outerFn(outerExec)
+	var startTime time.Time
+	if e.trackOverhead {
+		startTime = time.Now()
+	}
+	var er *common.PolicyResult[R]
+	if len(e.interceptors) == 0 {
+		er = compose()(outerExec)
+	} else {
+		er = e.intercept(compose, outerExec)
+	}
+	var overheadTime time.Duration
+	if e.trackOverhead {
+		// Concurrent attempts, such as hedges, may spend more time in the fn than the elapsed time
+		overheadTime = max(0, time.Since(startTime)-time.Duration(fnTime.Load()))
+	}
+
+	// Replace the result for failures, if configured, unless it was provided by a Fallback
+	if e.failureResult != nil && !er.SuccessAll && er.Error != nil && !er.Fallback {
+		c := *er
+		c.Result = *e.failureResult
+		er = &c
+	}
+	if debugger != nil {
+		debugger.executionDone(outerExec, er.SuccessAll, er.Error)
+	}
+	if metrics != nil {
+		recordExecutionMetrics(metrics, outerExec, er.SuccessAll)
+	}
+	if span != nil {
+		span.SetAttribute("failsafe.attempts", outerExec.Attempts())
+		span.SetAttribute("failsafe.success", er.SuccessAll)
+		span.End(er.Error)
+	}
 
-	if e.onSuccess != nil && er.SuccessAll {
-		e.onSuccess(newExecutionDoneEvent(outerExec, er))
-	} else if e.onFailure != nil && !er.SuccessAll {
-		e.onFailure(newExecutionDoneEvent(outerExec, er))
+	if e.onSuccess == nil && e.onFailure == nil && e.onDone == nil && doneEvent == nil {
+		return er
+	}
+	event := newExecutionDoneEvent(outerExec, er)
+	if e.discardResults {
+		event.ExecutionStats = newExecutionStatsSnapshot(outerExec)
+		event.Result = *(new(R))
+	}
+	event.OverheadTime = overheadTime
+	if attempts != nil {
+		event.attemptResults = attempts.snapshot(e.discardResults)
+	}
+	if e.trackTerminal && er.Error != nil && !er.SuccessAll {
+		if index := terminalErrs.indexOf(er.Error); index != 0 {
+			event.terminalPolicy = index
+			event.terminalPolicyType = policyType(policies[index-1])
+		}
 	}
-	if e.onDone != nil {
-		e.onDone(newExecutionDoneEvent(outerExec, er))
+	if doneEvent != nil {
+		*doneEvent = event
 	}
+	canceled := e.cancelAsDone && !er.SuccessAll && outerExec.Context().Err() != nil
+	e.callListeners(event, er.SuccessAll, canceled, outerExec.logger)
 	return er
 }
+
+// intercept performs the execution via the interceptors, which wrap the policies that the compose func composes, and
+// returns the result. If an interceptor returns the same error as the policies, the policies' success is retained.
+func (e *executor[R]) intercept(compose func() func(Execution[R]) *common.PolicyResult[R], outerExec *execution[R]) *common.PolicyResult[R] {
+	var policyResult atomic.Pointer[common.PolicyResult[R]]
+	next := ExecFn[R](func(exec Execution[R]) (R, error) {
+		execInternal, ok := exec.(*execution[R])
+		if !ok {
+			execInternal = outerExec
+		}
+		if policyResult.Load() != nil {
+			// Start a new attempt when the execution is performed again
+			if cancelResult := execInternal.InitializeRetry(); cancelResult != nil {
+				policyResult.Store(cancelResult)
+				return cancelResult.Result, cancelResult.Error
+			}
+		}
+		er := compose()(execInternal)
+		policyResult.Store(er)
+		return er.Result, er.Error
+	})
+	for i := len(e.interceptors) - 1; i >= 0; i-- {
+		next = e.interceptors[i](next)
+	}
+
+	result, err := next(outerExec)
+	if er := policyResult.Load(); er != nil && isSameError(er.Error, err) {
+		c := *er
+		c.Result = result
+		return &c
+	}
+	return &common.PolicyResult[R]{
+		Result:     result,
+		Error:      err,
+		Done:       true,
+		Success:    err == nil,
+		SuccessAll: err == nil,
+	}
+}
+
+// callListeners calls the done listeners with the event, abandoning them if they exceed any listenerTimeout. If canceled
+// is true, only the OnDone listener is called. Listeners that time out or panic are reported via reportListenerError.
+func (e *executor[R]) callListeners(event ExecutionDoneEvent[R], success bool, canceled bool, logger *slog.Logger) {
+	callListeners := func() {
+		if !canceled {
+			if e.onSuccess != nil && success {
+				e.onSuccess(event)
+			} else if e.onFailure != nil && !success {
+				e.onFailure(event)
+			}
+		}
+		if e.onDone != nil {
+			e.onDone(event)
+		}
+	}
+	if e.listenerTimeout <= 0 {
+		callListeners()
+		return
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			if r := recover(); r != nil {
+				e.reportListenerError(newPanicError(r), logger)
+			}
+		}()
+		callListeners()
+	}()
+	timer := time.NewTimer(e.listenerTimeout)
+	select {
+	case <-done:
+		timer.Stop()
+	case <-timer.C:
+		e.reportListenerError(ErrListenerTimeout, logger)
+	}
+}
+
+// reportListenerError logs the err as a warning to the logger, if any, and calls any OnListenerError listener.
+func (e *executor[R]) reportListenerError(err error, logger *slog.Logger) {
+	if logger != nil {
+		logger.Warn("listener failed", "error", err)
+	}
+	if e.onListenerError != nil {
+		e.onListenerError(err)
+	}
+}
+
+// applyWithTerminalTracking applies the policy executor to the innerFn, recording the 1-based policy index in
+// terminalErrs when the policy returns an error that differs from the errors returned by the innerFn during the same
+// call. The failure is also written to the debugger and recorded for the current attempt, if either is configured.
+func applyWithTerminalTracking[R any](pe policyExecutor[R], index int, innerFn func(Execution[R]) *common.PolicyResult[R], terminalErrs *terminalErrors, debugger *executionDebugger, attempts *attemptResults[R], policy Policy[R]) func(Execution[R]) *common.PolicyResult[R] {
+	fn := pe.Apply(func(exec Execution[R]) *common.PolicyResult[R] {
+		var inner *innerErrors
+		if execInternal, ok := exec.(*execution[R]); ok {
+			inner = execInternal.innerErrors
+		}
+		if inner != nil {
+			inner.start()
+		}
+		result := innerFn(exec)
+		if inner != nil {
+			inner.finish(result.Error)
+		}
+		return result
+	})
+	return func(exec Execution[R]) *common.PolicyResult[R] {
+		// Track the inner errors for this call on a copy of the execution, so that concurrent calls don't share them
+		inner := &innerErrors{}
+		if execInternal, ok := exec.(*execution[R]); ok {
+			c := execInternal.copy()
+			c.innerErrors = inner
+			exec = c
+		}
+		var startTime time.Time
+		if attempts != nil {
+			startTime = time.Now()
+		}
+		result := fn(exec)
+		if result.Error != nil && isComparable(result.Error) {
+			if done, found := inner.contains(result.Error); !found {
+				terminalErrs.record(result.Error, index+1)
+				if debugger != nil {
+					debugger.policyFailed(policyType(policy), index, !done, result.Error)
+				}
+				if attempts != nil {
+					attempts.recordTerminated(exec.Attempts(), result.Error, policyType(policy), startTime)
+				}
+			}
+		}
+		return result
+	}
+}
+
+// policyType returns the name of the package that the policy's type belongs to.
+func policyType(policy any) string {
+	if wrapped, ok := policy.(interface{ unwrap() any }); ok {
+		policy = wrapped.unwrap()
+	}
+	t := reflect.TypeOf(policy)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return path.Base(t.PkgPath())
+}
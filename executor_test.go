@@ -1,15 +1,23 @@
 package failsafe_test
 
 import (
+	"bytes"
 	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/circuitbreaker"
 	"github.com/failsafe-go/failsafe-go/fallback"
 	"github.com/failsafe-go/failsafe-go/internal/testutil"
 	"github.com/failsafe-go/failsafe-go/retrypolicy"
+	"github.com/failsafe-go/failsafe-go/timeout"
 )
 
 func TestRunWithSuccess(t *testing.T) {
@@ -73,3 +81,1189 @@ func TestExecutionWithNoPolicies(t *testing.T) {
 	assert.Equal(t, "test", result)
 	assert.ErrorIs(t, testutil.ErrInvalidArgument, err)
 }
+
+// Asserts that a configured failure result is returned when policies are exceeded.
+func TestWithFailureResult(t *testing.T) {
+	rp := retrypolicy.WithDefaults[int]()
+	executor := failsafe.NewExecutor[int](rp).WithFailureResult(-1)
+
+	result, err := executor.Get(func() (int, error) {
+		return 0, testutil.ErrInvalidArgument
+	})
+	assert.Equal(t, -1, result)
+	assert.ErrorIs(t, err, retrypolicy.ErrExceeded)
+
+	// Successful results are not replaced
+	result, err = executor.Get(func() (int, error) {
+		return 0, nil
+	})
+	assert.Equal(t, 0, result)
+	assert.Nil(t, err)
+
+	// Non-zero results from failed executions are replaced
+	result, err = executor.Get(func() (int, error) {
+		return 5, testutil.ErrInvalidArgument
+	})
+	assert.Equal(t, -1, result)
+	assert.ErrorIs(t, err, retrypolicy.ErrExceeded)
+}
+
+// Asserts that a result provided by a Fallback takes precedence over a configured failure result.
+func TestWithFailureResultAndFallback(t *testing.T) {
+	fb := fallback.BuilderWithFunc(func(exec failsafe.Execution[int]) (int, error) {
+		return 5, exec.LastError()
+	}).Build()
+	executor := failsafe.NewExecutor[int](fb).WithFailureResult(-1)
+
+	result, err := executor.Get(func() (int, error) {
+		return 0, testutil.ErrInvalidArgument
+	})
+	assert.Equal(t, 5, result)
+	assert.ErrorIs(t, err, testutil.ErrInvalidArgument)
+
+	// A zero value provided by a Fallback is not replaced
+	fb = fallback.BuilderWithFunc(func(exec failsafe.Execution[int]) (int, error) {
+		return 0, exec.LastError()
+	}).Build()
+	executor = failsafe.NewExecutor[int](fb).WithFailureResult(-1)
+	result, err = executor.Get(func() (int, error) {
+		return 5, testutil.ErrInvalidArgument
+	})
+	assert.Equal(t, 0, result)
+	assert.ErrorIs(t, err, testutil.ErrInvalidArgument)
+}
+
+// Asserts that OnSuccess, rather than OnFailure, is called when a Fallback recovers from a failure.
+func TestOnSuccessAfterFallbackRecovers(t *testing.T) {
+	for _, policies := range [][]failsafe.Policy[int]{
+		{fallback.WithResult(5)},
+		{fallback.WithResult(5), retrypolicy.WithDefaults[int]()},
+		{retrypolicy.WithDefaults[int](), fallback.WithResult(5)},
+	} {
+		// Given
+		var successes, failures int
+		executor := failsafe.NewExecutor[int](policies...).
+			OnSuccess(func(e failsafe.ExecutionDoneEvent[int]) {
+				successes++
+			}).
+			OnFailure(func(e failsafe.ExecutionDoneEvent[int]) {
+				failures++
+			})
+
+		// When
+		result, err := executor.Get(func() (int, error) {
+			return 0, testutil.ErrInvalidArgument
+		})
+
+		// Then
+		assert.Equal(t, 5, result)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, successes)
+		assert.Equal(t, 0, failures)
+	}
+}
+
+func TestGetWithTrace(t *testing.T) {
+	// Given
+	rp := retrypolicy.Builder[bool]().WithDelay(10 * time.Millisecond).Build()
+	cb := circuitbreaker.Builder[bool]().WithFailureThreshold(2).Build()
+
+	// When
+	_, err, trace := failsafe.NewExecutor[bool](rp, cb).GetWithTrace(func() (bool, error) {
+		return false, testutil.ErrInvalidState
+	})
+
+	// Then
+	assert.ErrorIs(t, err, retrypolicy.ErrExceeded)
+	assert.Len(t, trace.Spans, 1)
+	rpSpan := trace.Spans[0]
+	assert.Equal(t, "retrypolicy", rpSpan.Policy)
+	assert.Equal(t, 0, rpSpan.PolicyIndex)
+	assert.ErrorIs(t, rpSpan.Error, retrypolicy.ErrExceeded)
+	assert.Len(t, rpSpan.Children, 3)
+	for i, cbSpan := range rpSpan.Children {
+		assert.Equal(t, "circuitbreaker", cbSpan.Policy)
+		assert.Equal(t, 1, cbSpan.PolicyIndex)
+		if i > 0 {
+			assert.GreaterOrEqual(t, cbSpan.Delay, 10*time.Millisecond)
+		}
+	}
+
+	// Attempts that reached the func before the breaker opened
+	for _, cbSpan := range rpSpan.Children[:2] {
+		assert.ErrorIs(t, cbSpan.Error, testutil.ErrInvalidState)
+		assert.Len(t, cbSpan.Children, 1)
+		assert.Equal(t, "", cbSpan.Children[0].Policy)
+		assert.Equal(t, -1, cbSpan.Children[0].PolicyIndex)
+		assert.ErrorIs(t, cbSpan.Children[0].Error, testutil.ErrInvalidState)
+	}
+
+	// Attempt that was rejected by the open breaker
+	assert.ErrorIs(t, rpSpan.Children[2].Error, circuitbreaker.ErrOpen)
+	assert.Len(t, rpSpan.Children[2].Children, 0)
+}
+
+// Asserts that WithInnerFirst composes policies in the reverse order of NewExecutor.
+func TestWithInnerFirst(t *testing.T) {
+	// Given
+	fb := fallback.WithResult[bool](true)
+	rp := retrypolicy.WithDefaults[bool]()
+	cb := circuitbreaker.WithDefaults[bool]()
+	fn := func() (bool, error) {
+		return false, testutil.ErrInvalidState
+	}
+	policyTypes := func(trace failsafe.ExecutionTrace[bool]) []string {
+		var types []string
+		for spans := trace.Spans; len(spans) > 0 && spans[0].Policy != ""; spans = spans[0].Children {
+			types = append(types, spans[0].Policy)
+		}
+		return types
+	}
+
+	// When
+	innerFirstResult, innerFirstErr, innerFirstTrace := failsafe.WithInnerFirst[bool](cb, rp, fb).GetWithTrace(fn)
+	cb.Close()
+	result, err, trace := failsafe.NewExecutor[bool](fb, rp, cb).GetWithTrace(fn)
+
+	// Then
+	assert.Equal(t, []string{"fallback", "retrypolicy", "circuitbreaker"}, policyTypes(innerFirstTrace))
+	assert.Equal(t, policyTypes(trace), policyTypes(innerFirstTrace))
+	assert.Equal(t, result, innerFirstResult)
+	assert.Equal(t, err, innerFirstErr)
+	assert.True(t, innerFirstResult)
+}
+
+// Asserts that an execution returns within the listener timeout when a listener hangs, and that the timeout is reported.
+func TestWithListenerTimeout(t *testing.T) {
+	// Given
+	release := make(chan struct{})
+	defer close(release)
+	var listenerErr error
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	executor := failsafe.NewExecutor[any](retrypolicy.WithDefaults[any]()).
+		WithListenerTimeout(50 * time.Millisecond).
+		WithLoggerFunc(func(exec failsafe.Execution[any]) *slog.Logger {
+			return logger
+		}).
+		OnDone(func(e failsafe.ExecutionDoneEvent[any]) {
+			<-release
+		}).
+		OnListenerError(func(err error) {
+			listenerErr = err
+		})
+
+	// When
+	var err error
+	elapsed := testutil.Timed(func() {
+		err = executor.Run(func() error {
+			return nil
+		})
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, elapsed, 50*time.Millisecond)
+	assert.Less(t, elapsed, time.Second)
+	assert.ErrorIs(t, listenerErr, failsafe.ErrListenerTimeout)
+	assert.Regexp(t, `level=WARN msg="listener failed" error="listener timeout exceeded"`, buf.String())
+}
+
+// Asserts that a listener that panics while a listener timeout is configured is recovered and reported.
+func TestWithListenerTimeoutAndPanic(t *testing.T) {
+	// Given
+	listenerErrs := make(chan error, 1)
+	executor := failsafe.NewExecutor[any](retrypolicy.WithDefaults[any]()).
+		WithListenerTimeout(time.Second).
+		OnDone(func(e failsafe.ExecutionDoneEvent[any]) {
+			panic("listener")
+		}).
+		OnListenerError(func(err error) {
+			listenerErrs <- err
+		})
+
+	// When
+	err := executor.Run(func() error {
+		return nil
+	})
+
+	// Then
+	assert.NoError(t, err)
+	listenerErr := <-listenerErrs
+	assert.ErrorIs(t, listenerErr, failsafe.ErrPanic)
+	var panicErr *failsafe.PanicError
+	assert.ErrorAs(t, listenerErr, &panicErr)
+	assert.Equal(t, "listener", panicErr.Value())
+}
+
+// Asserts that the time spent in policies, excluding the fn, is recorded when overhead tracking is enabled.
+func TestWithOverheadTracking(t *testing.T) {
+	// Given
+	rp := retrypolicy.Builder[any]().WithDelay(20 * time.Millisecond).Build()
+	var overheadTime, untrackedOverheadTime time.Duration
+	var execTime time.Duration
+	fn := func(exec failsafe.Execution[any]) error {
+		time.Sleep(10 * time.Millisecond)
+		if exec.Attempts() < 3 {
+			return testutil.ErrInvalidState
+		}
+		execTime = exec.ElapsedTime()
+		return nil
+	}
+
+	// When
+	err := failsafe.NewExecutor[any](rp).
+		WithOverheadTracking().
+		OnDone(func(e failsafe.ExecutionDoneEvent[any]) {
+			overheadTime = e.OverheadTime
+		}).
+		RunWithExecution(fn)
+	assert.NoError(t, failsafe.NewExecutor[any](rp).
+		OnDone(func(e failsafe.ExecutionDoneEvent[any]) {
+			untrackedOverheadTime = e.OverheadTime
+		}).
+		RunWithExecution(fn))
+
+	// Then
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, overheadTime, 40*time.Millisecond)
+	assert.LessOrEqual(t, overheadTime, execTime-30*time.Millisecond)
+	assert.Equal(t, time.Duration(0), untrackedOverheadTime)
+}
+
+// Asserts that debug lines are written for an execution's attempts, retries, rejections, and completion.
+func TestWithDebugWriter(t *testing.T) {
+	// Given
+	rp := retrypolicy.Builder[any]().WithMaxRetries(2).WithDelay(10 * time.Millisecond).Build()
+	cb := circuitbreaker.Builder[any]().WithFailureThreshold(2).Build()
+	var buf bytes.Buffer
+
+	// When
+	err := failsafe.NewExecutor[any](rp, cb).WithDebugWriter(&buf).Run(testutil.RunFn(testutil.ErrInvalidState))
+
+	// Then
+	assert.ErrorIs(t, err, retrypolicy.ErrExceeded)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	patterns := []string{
+		`^failsafe: execution started$`,
+		`^failsafe: attempt 1 started$`,
+		`^failsafe: attempt 1 failed after \S+: invalid state$`,
+		`^failsafe: attempt 2 started as a retry after \d+ms$`,
+		`^failsafe: attempt 2 failed after \S+: invalid state$`,
+		`^failsafe: circuitbreaker at index 1 rejected the attempt: circuit breaker open$`,
+		`^failsafe: retrypolicy at index 0 failed: retries exceeded`,
+		`^failsafe: execution failed after 3 attempts in \d+ms: retries exceeded`,
+	}
+	assert.Len(t, lines, len(patterns))
+	for i, pattern := range patterns {
+		if i < len(lines) {
+			assert.Regexp(t, pattern, lines[i])
+		}
+	}
+}
+
+type testContextKey struct{}
+
+// Asserts that GetWithContextFunc provides a live, cancellable context for each attempt.
+func TestGetWithContextFunc(t *testing.T) {
+	// Given
+	rp := retrypolicy.WithDefaults[string]()
+	to := timeout.With[string](50 * time.Millisecond)
+	executor := failsafe.NewExecutor[string](rp, to)
+	ctx := context.WithValue(context.Background(), testContextKey{}, "value")
+	var attemptCtxs []context.Context
+	fn := func(ctx context.Context) (string, error) {
+		attemptCtxs = append(attemptCtxs, ctx)
+		assert.NoError(t, ctx.Err())
+		_, hasDeadline := ctx.Deadline()
+		assert.True(t, hasDeadline)
+		if len(attemptCtxs) < 3 {
+			<-ctx.Done()
+			return "", ctx.Err()
+		}
+		return ctx.Value(testContextKey{}).(string), nil
+	}
+
+	// When
+	result, err := failsafe.GetWithContextFunc(executor, ctx, fn)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "value", result)
+	assert.Len(t, attemptCtxs, 3)
+	assert.Error(t, attemptCtxs[0].Err())
+	assert.Error(t, attemptCtxs[1].Err())
+	assert.NotEqual(t, attemptCtxs[0], attemptCtxs[1])
+}
+
+// Asserts that RunWithContext and GetWithContext provide a context that is canceled when a Timeout is exceeded.
+func TestGetWithContext(t *testing.T) {
+	// Given
+	to := timeout.With[string](50 * time.Millisecond)
+	executor := failsafe.NewExecutor[string](to).WithContext(context.WithValue(context.Background(), testContextKey{}, "value"))
+	var attemptCtx context.Context
+	fn := func(ctx context.Context) (string, error) {
+		attemptCtx = ctx
+		_, hasDeadline := ctx.Deadline()
+		assert.True(t, hasDeadline)
+		assert.Equal(t, "value", ctx.Value(testContextKey{}))
+		<-ctx.Done()
+		return "", ctx.Err()
+	}
+
+	// When
+	result, err := executor.GetWithContext(fn)
+
+	// Then
+	assert.ErrorIs(t, err, timeout.ErrExceeded)
+	assert.Empty(t, result)
+	assert.Error(t, attemptCtx.Err())
+
+	// When
+	err = executor.RunWithContext(func(ctx context.Context) error {
+		_, err := fn(ctx)
+		return err
+	})
+
+	// Then
+	assert.ErrorIs(t, err, timeout.ErrExceeded)
+}
+
+// Asserts that a slow but successful execution is treated as a failure when SuccessIfFast is configured.
+func TestSuccessIfFast(t *testing.T) {
+	// Given
+	slowFn := func() (string, error) {
+		time.Sleep(30 * time.Millisecond)
+		return "slow", nil
+	}
+	var failed bool
+
+	// When
+	result, err := failsafe.NewExecutor[string]().
+		SuccessIfFast(10 * time.Millisecond).
+		OnFailure(func(e failsafe.ExecutionDoneEvent[string]) {
+			failed = true
+		}).
+		Get(slowFn)
+
+	// Then
+	assert.ErrorIs(t, err, failsafe.ErrTooSlow)
+	assert.Equal(t, "slow", result)
+	assert.True(t, failed)
+
+	// When handled by a Fallback
+	result, err = failsafe.NewExecutor[string](fallback.WithResult("fallback")).
+		SuccessIfFast(10 * time.Millisecond).
+		Get(slowFn)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "fallback", result)
+
+	// When fast
+	result, err = failsafe.NewExecutor[string]().
+		SuccessIfFast(time.Second).
+		Get(slowFn)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "slow", result)
+}
+
+func TestGetToChannel(t *testing.T) {
+	t.Run("should send results with back-pressure", func(t *testing.T) {
+		// Given
+		rp := retrypolicy.Builder[int]().WithMaxRetries(2).Build()
+		out := make(chan int, 1)
+		var attempts, produced int
+
+		// When
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- failsafe.NewExecutor[int](rp).GetToChannel(func() (int, bool, error) {
+				attempts++
+				// Fail every other attempt, which should be retried
+				if attempts%2 == 1 {
+					return 0, false, testutil.ErrInvalidState
+				}
+				if produced == 5 {
+					return 0, true, nil
+				}
+				produced++
+				return produced, false, nil
+			}, out)
+			close(out)
+		}()
+		var results []int
+		for result := range out {
+			time.Sleep(10 * time.Millisecond)
+			results = append(results, result)
+		}
+
+		// Then
+		assert.NoError(t, <-errCh)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, results)
+	})
+
+	t.Run("should stop when policies are exceeded", func(t *testing.T) {
+		// Given
+		rp := retrypolicy.Builder[int]().WithMaxRetries(2).ReturnLastFailure().Build()
+		out := make(chan int, 1)
+
+		// When
+		err := failsafe.NewExecutor[int](rp).GetToChannel(func() (int, bool, error) {
+			return 0, false, testutil.ErrInvalidState
+		}, out)
+
+		// Then
+		assert.ErrorIs(t, err, testutil.ErrInvalidState)
+		assert.Empty(t, out)
+	})
+
+	t.Run("should stop when context is canceled", func(t *testing.T) {
+		// Given
+		ctx, cancel := context.WithCancel(context.Background())
+		out := make(chan int)
+		time.AfterFunc(50*time.Millisecond, cancel)
+
+		// When
+		err := failsafe.NewExecutor[int]().WithContext(ctx).GetToChannel(func() (int, bool, error) {
+			return 1, false, nil
+		}, out)
+
+		// Then
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestSupervise(t *testing.T) {
+	t.Run("should restart until canceled", func(t *testing.T) {
+		// Given
+		rp := retrypolicy.Builder[any]().
+			WithMaxRetries(2).
+			WithDelay(5 * time.Millisecond).
+			ReturnLastFailure().
+			Build()
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		var executions atomic.Int32
+
+		// When
+		_, err := failsafe.Supervise[any](ctx, failsafe.NewExecutor[any](rp), func(exec failsafe.Execution[any]) (any, error) {
+			executions.Add(1)
+			return nil, testutil.ErrInvalidState
+		})
+
+		// Then
+		assert.ErrorIs(t, err, testutil.ErrInvalidState)
+		assert.Greater(t, int(executions.Load()), 3, "expected the task to be restarted after retries were exceeded")
+	})
+
+	t.Run("should return result when successful", func(t *testing.T) {
+		// Given
+		rp := retrypolicy.Builder[any]().ReturnLastFailure().Build()
+		executions := 0
+
+		// When
+		result, err := failsafe.Supervise[any](context.Background(), failsafe.NewExecutor[any](rp), func(exec failsafe.Execution[any]) (any, error) {
+			executions++
+			if executions < 5 {
+				return nil, testutil.ErrInvalidState
+			}
+			return "done", nil
+		})
+
+		// Then
+		assert.NoError(t, err)
+		assert.Equal(t, "done", result)
+		assert.Equal(t, 5, executions)
+	})
+
+	t.Run("should return context error when canceled before failing", func(t *testing.T) {
+		// Given
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// When
+		_, err := failsafe.Supervise[any](ctx, failsafe.NewExecutor[any](), func(exec failsafe.Execution[any]) (any, error) {
+			return nil, exec.Context().Err()
+		})
+
+		// Then
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestWithoutResultRetention(t *testing.T) {
+	// Given
+	largeResult := make([]byte, 1<<20)
+	rp := retrypolicy.WithDefaults[[]byte]()
+	var doneEvent, successEvent failsafe.ExecutionDoneEvent[[]byte]
+	executor := failsafe.NewExecutor[[]byte](rp).
+		WithoutResultRetention().
+		OnDone(func(e failsafe.ExecutionDoneEvent[[]byte]) {
+			doneEvent = e
+		}).
+		OnSuccess(func(e failsafe.ExecutionDoneEvent[[]byte]) {
+			successEvent = e
+		})
+
+	// When
+	result, err := executor.GetWithExecution(func(exec failsafe.Execution[[]byte]) ([]byte, error) {
+		if exec.Attempts() == 1 {
+			return nil, testutil.ErrInvalidState
+		}
+		return largeResult, nil
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Len(t, result, 1<<20)
+	assert.Nil(t, doneEvent.Result)
+	assert.Nil(t, successEvent.Result)
+	assert.Equal(t, 2, doneEvent.Attempts())
+	assert.Equal(t, 1, doneEvent.Retries())
+	_, isAttempt := doneEvent.ExecutionStats.(failsafe.ExecutionAttempt[[]byte])
+	assert.False(t, isAttempt, "the event should not reference the execution")
+}
+
+func TestWithCancellationAsComplete(t *testing.T) {
+	test := func(t *testing.T, cancellationAsComplete bool) (doneCalled, failureCalled bool) {
+		ctx, cancel := context.WithCancel(context.Background())
+		executor := failsafe.NewExecutor[any](retrypolicy.WithDefaults[any]()).
+			WithContext(ctx).
+			OnDone(func(e failsafe.ExecutionDoneEvent[any]) {
+				doneCalled = true
+			}).
+			OnFailure(func(e failsafe.ExecutionDoneEvent[any]) {
+				failureCalled = true
+			}).
+			OnSuccess(func(e failsafe.ExecutionDoneEvent[any]) {
+				assert.Fail(t, "OnSuccess should not be called")
+			})
+		if cancellationAsComplete {
+			executor = executor.WithCancellationAsComplete()
+		}
+
+		_, err := executor.GetWithExecution(func(exec failsafe.Execution[any]) (any, error) {
+			cancel()
+			return nil, exec.Context().Err()
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		return
+	}
+
+	t.Run("with cancellation as complete", func(t *testing.T) {
+		doneCalled, failureCalled := test(t, true)
+		assert.True(t, doneCalled)
+		assert.False(t, failureCalled)
+	})
+
+	t.Run("by default", func(t *testing.T) {
+		doneCalled, failureCalled := test(t, false)
+		assert.True(t, doneCalled)
+		assert.True(t, failureCalled)
+	})
+
+	t.Run("should call OnFailure when not canceled", func(t *testing.T) {
+		failureCalled := false
+		_, err := failsafe.NewExecutor[any](retrypolicy.WithDefaults[any]()).
+			WithCancellationAsComplete().
+			OnFailure(func(e failsafe.ExecutionDoneEvent[any]) {
+				failureCalled = true
+			}).
+			Get(func() (any, error) {
+				return nil, testutil.ErrInvalidState
+			})
+		assert.ErrorIs(t, err, testutil.ErrInvalidState)
+		assert.True(t, failureCalled)
+	})
+}
+
+func TestGetAccumulating(t *testing.T) {
+	t.Run("should accumulate across attempts", func(t *testing.T) {
+		// Given
+		rp := retrypolicy.Builder[[]string]().WithMaxAttempts(5).Build()
+		var seen [][]string
+
+		// When
+		parts, err := failsafe.GetAccumulating(failsafe.NewExecutor[[]string](rp), []string{}, func(exec failsafe.Execution[[]string], uploaded []string) ([]string, bool, error) {
+			seen = append(seen, uploaded)
+			switch exec.Attempts() {
+			case 1:
+				// Make progress, then fail
+				return append(uploaded, "part1"), false, testutil.ErrConnecting
+			case 2:
+				// Make progress without failing
+				return append(uploaded, "part2"), false, nil
+			default:
+				return append(uploaded, "part3"), true, nil
+			}
+		})
+
+		// Then
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"part1", "part2", "part3"}, parts)
+		assert.Equal(t, [][]string{{}, {"part1"}, {"part1", "part2"}}, seen)
+	})
+
+	t.Run("should return accumulator when attempts are exhausted", func(t *testing.T) {
+		// Given
+		rp := retrypolicy.Builder[int]().WithMaxAttempts(3).ReturnLastFailure().Build()
+
+		// When
+		total, err := failsafe.GetAccumulating(failsafe.NewExecutor[int](rp), 0, func(exec failsafe.Execution[int], acc int) (int, bool, error) {
+			return acc + 1, false, nil
+		})
+
+		// Then
+		assert.ErrorIs(t, err, failsafe.ErrIncomplete)
+		assert.Equal(t, 3, total)
+	})
+}
+
+type requestIDKey struct{}
+
+func TestWithLoggerFunc(t *testing.T) {
+	// Given
+	var buf bytes.Buffer
+	handler := slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+	rp := retrypolicy.Builder[any]().WithMaxRetries(1).ReturnLastFailure().Build()
+	cb := circuitbreaker.WithDefaults[any]()
+	executor := failsafe.NewExecutor[any](rp, cb).
+		WithContext(context.WithValue(context.Background(), requestIDKey{}, "abc")).
+		WithLoggerFunc(func(exec failsafe.Execution[any]) *slog.Logger {
+			return slog.New(handler).With("requestID", exec.Context().Value(requestIDKey{}))
+		})
+
+	// When
+	err := executor.Run(func() error {
+		return testutil.ErrInvalidState
+	})
+
+	// Then
+	assert.Error(t, err)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 3)
+	assert.Regexp(t, `msg="circuit breaker state changed" requestID=abc policy=CircuitBreaker attempt=1 oldState=closed newState=open`, lines[0])
+	assert.Regexp(t, `msg="retry scheduled" requestID=abc policy=RetryPolicy attempt=1 delay=0s error="invalid state"`, lines[1])
+	assert.Regexp(t, `msg="retries exceeded" requestID=abc policy=RetryPolicy attempt=2 error="circuit breaker open"`, lines[2])
+}
+
+type testMetrics struct {
+	mtx    sync.Mutex
+	values map[string]float64
+}
+
+func (m *testMetrics) IncrementCounter(name string, labels map[string]string) {
+	m.record(name, labels, 1, true)
+}
+
+func (m *testMetrics) SetGauge(name string, value float64, labels map[string]string) {
+	m.record(name, labels, value, false)
+}
+
+func (m *testMetrics) ObserveHistogram(name string, value float64, labels map[string]string) {
+	m.record(name, labels, value, true)
+}
+
+func (m *testMetrics) record(name string, labels map[string]string, value float64, add bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	for k, v := range labels {
+		name += "," + k + "=" + v
+	}
+	if add {
+		m.values[name] += value
+	} else {
+		m.values[name] = value
+	}
+}
+
+// Asserts that an Executor and its policies publish metrics when WithMetrics is configured.
+func TestWithMetrics(t *testing.T) {
+	// Given
+	metrics := &testMetrics{values: make(map[string]float64)}
+	rp := retrypolicy.Builder[any]().WithMaxRetries(1).ReturnLastFailure().Build()
+	to := timeout.With[any](10 * time.Millisecond)
+	executor := failsafe.NewExecutor[any](rp, to).WithMetrics(metrics)
+
+	// When
+	err := executor.RunWithExecution(func(exec failsafe.Execution[any]) error {
+		<-exec.Canceled()
+		return nil
+	})
+
+	// Then
+	assert.ErrorIs(t, err, timeout.ErrExceeded)
+	assert.Equal(t, float64(1), metrics.values[failsafe.MetricExecutions+",outcome=failure"])
+	assert.Equal(t, float64(2), metrics.values[failsafe.MetricExecutionAttempts])
+	assert.Equal(t, float64(1), metrics.values[failsafe.MetricRetries])
+	assert.Equal(t, float64(2), metrics.values[failsafe.MetricTimeoutsExceeded])
+	assert.Greater(t, metrics.values[failsafe.MetricExecutionDuration], float64(0))
+}
+
+type tenantKey struct{}
+
+// contextMetrics is a failsafe.ContextMetrics that records counters with the tenant from the context.
+type contextMetrics struct {
+	*testMetrics
+	tenant string
+}
+
+func (m *contextMetrics) WithContext(ctx context.Context) failsafe.Metrics {
+	return &contextMetrics{testMetrics: m.testMetrics, tenant: ctx.Value(tenantKey{}).(string)}
+}
+
+func (m *contextMetrics) IncrementCounter(name string, labels map[string]string) {
+	m.testMetrics.IncrementCounter(name+",tenant="+m.tenant, labels)
+}
+
+// Asserts that metrics for an execution and its policies are published via a ContextMetrics bound to the execution's
+// context.
+func TestWithContextMetrics(t *testing.T) {
+	// Given
+	metrics := &contextMetrics{testMetrics: &testMetrics{values: make(map[string]float64)}}
+	rp := retrypolicy.Builder[any]().WithMaxRetries(1).Build()
+	executor := failsafe.NewExecutor[any](rp).WithMetrics(metrics)
+
+	// When
+	executor.WithContext(context.WithValue(context.Background(), tenantKey{}, "a")).Run(testutil.RunFn(testutil.ErrInvalidState))
+	executor.WithContext(context.WithValue(context.Background(), tenantKey{}, "b")).Run(testutil.RunFn(nil))
+
+	// Then
+	assert.Equal(t, float64(1), metrics.values[failsafe.MetricExecutions+",tenant=a,outcome=failure"])
+	assert.Equal(t, float64(1), metrics.values[failsafe.MetricRetries+",tenant=a"])
+	assert.Equal(t, float64(1), metrics.values[failsafe.MetricExecutions+",tenant=b,outcome=success"])
+	assert.Equal(t, float64(0), metrics.values[failsafe.MetricRetries+",tenant=b"])
+}
+
+// Asserts that a Toggleable policy passes through to the func while disabled, including mid-execution.
+func TestToggleable(t *testing.T) {
+	rp := failsafe.Toggleable[any](retrypolicy.Builder[any]().WithMaxRetries(-1).Build())
+	executor := failsafe.NewExecutor[any](rp)
+
+	t.Run("when disabled mid-execution", func(t *testing.T) {
+		// When
+		attempts := 0
+		err := executor.Run(func() error {
+			attempts++
+			if attempts == 3 {
+				rp.SetEnabled(false)
+			}
+			return testutil.ErrInvalidState
+		})
+
+		// Then
+		assert.ErrorIs(t, err, testutil.ErrInvalidState)
+		assert.Equal(t, 3, attempts)
+		assert.False(t, rp.IsEnabled())
+	})
+
+	t.Run("when re-enabled", func(t *testing.T) {
+		// Given
+		rp.SetEnabled(true)
+
+		// When
+		attempts := 0
+		err := executor.Run(func() error {
+			attempts++
+			if attempts < 3 {
+				return testutil.ErrInvalidState
+			}
+			return nil
+		})
+
+		// Then
+		assert.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+		assert.True(t, rp.IsEnabled())
+	})
+}
+
+func TestWithAttemptResults(t *testing.T) {
+	t.Run("with scripted outcomes", func(t *testing.T) {
+		// Given
+		rp := retrypolicy.Builder[string]().WithMaxRetries(3).Build()
+		var attemptResults []failsafe.AttemptResult[string]
+		executor := failsafe.NewExecutor[string](rp).
+			WithAttemptResults().
+			OnDone(func(e failsafe.ExecutionDoneEvent[string]) {
+				attemptResults = e.AttemptResults()
+			})
+
+		// When
+		result, err := executor.GetWithExecution(func(exec failsafe.Execution[string]) (string, error) {
+			switch exec.Attempts() {
+			case 1:
+				return "", testutil.ErrConnecting
+			case 2:
+				return "partial", testutil.ErrInvalidState
+			default:
+				return "done", nil
+			}
+		})
+
+		// Then
+		assert.NoError(t, err)
+		assert.Equal(t, "done", result)
+		assert.Len(t, attemptResults, 3)
+		expected := []struct {
+			result string
+			err    error
+		}{
+			{"", testutil.ErrConnecting},
+			{"partial", testutil.ErrInvalidState},
+			{"done", nil},
+		}
+		for i, ar := range attemptResults {
+			assert.Equal(t, i+1, ar.Attempt)
+			assert.Equal(t, expected[i].result, ar.Result)
+			assert.Equal(t, expected[i].err, ar.Error)
+			assert.Equal(t, "", ar.TerminalPolicyType)
+			assert.False(t, ar.EndTime.Before(ar.StartTime))
+		}
+	})
+
+	t.Run("with attempts terminated by a policy", func(t *testing.T) {
+		// Given
+		rp := retrypolicy.Builder[any]().WithMaxRetries(1).Build()
+		to := timeout.With[any](10 * time.Millisecond)
+		var attemptResults []failsafe.AttemptResult[any]
+		executor := failsafe.NewExecutor[any](rp, to).
+			WithAttemptResults().
+			OnDone(func(e failsafe.ExecutionDoneEvent[any]) {
+				attemptResults = e.AttemptResults()
+			})
+
+		// When
+		err := executor.RunWithExecution(func(exec failsafe.Execution[any]) error {
+			if exec.Attempts() == 1 {
+				<-exec.Canceled()
+			}
+			return nil
+		})
+
+		// Then
+		assert.NoError(t, err)
+		assert.Len(t, attemptResults, 2)
+		assert.ErrorIs(t, attemptResults[0].Error, timeout.ErrExceeded)
+		assert.Equal(t, "timeout", attemptResults[0].TerminalPolicyType)
+		assert.NoError(t, attemptResults[1].Error)
+		assert.Equal(t, "", attemptResults[1].TerminalPolicyType)
+	})
+
+	t.Run("when not enabled", func(t *testing.T) {
+		// Given
+		var event failsafe.ExecutionDoneEvent[any]
+		executor := failsafe.NewExecutor[any]().OnDone(func(e failsafe.ExecutionDoneEvent[any]) {
+			event = e
+		})
+
+		// When
+		err := executor.Run(func() error {
+			return nil
+		})
+
+		// Then
+		assert.NoError(t, err)
+		assert.Nil(t, event.AttemptResults())
+	})
+}
+
+// Asserts that ReplacePolicies affects subsequent executions but not in-flight executions.
+func TestReplacePolicies(t *testing.T) {
+	// Given
+	rp1 := retrypolicy.Builder[any]().WithMaxRetries(1).Build()
+	rp2 := retrypolicy.Builder[any]().WithMaxRetries(3).Build()
+	executor := failsafe.NewExecutor[any](rp1)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var inFlightAttempts atomic.Int32
+
+	// When
+	inFlight := executor.RunWithExecutionAsync(func(exec failsafe.Execution[any]) error {
+		if inFlightAttempts.Add(1) == 1 {
+			close(started)
+			<-release
+		}
+		return testutil.ErrInvalidState
+	})
+	<-started
+	executor.ReplacePolicies([]failsafe.Policy[any]{rp2})
+	var attempts int
+	err := executor.WithContext(context.Background()).RunWithExecution(func(exec failsafe.Execution[any]) error {
+		attempts = exec.Attempts()
+		return testutil.ErrInvalidState
+	})
+	close(release)
+
+	// Then
+	assert.ErrorIs(t, err, retrypolicy.ErrExceeded)
+	assert.Equal(t, 4, attempts)
+	assert.ErrorIs(t, inFlight.Error(), retrypolicy.ErrExceeded)
+	assert.Equal(t, int32(2), inFlightAttempts.Load())
+}
+
+// Asserts that RunWithResult provides stats about a retried execution.
+func TestRunWithResult(t *testing.T) {
+	// Given
+	rp := retrypolicy.Builder[any]().WithMaxRetries(2).Build()
+	executor := failsafe.NewExecutor[any](rp).WithTerminalPolicyTracking()
+
+	// When
+	event := executor.RunWithResult(func() error {
+		time.Sleep(10 * time.Millisecond)
+		return testutil.ErrInvalidState
+	})
+
+	// Then
+	assert.ErrorIs(t, event.Error, retrypolicy.ErrExceeded)
+	assert.ErrorIs(t, event.Error, testutil.ErrInvalidState)
+	assert.Nil(t, event.Result)
+	assert.Equal(t, 3, event.Attempts())
+	assert.Equal(t, 2, event.Retries())
+	assert.GreaterOrEqual(t, event.ElapsedTime(), 30*time.Millisecond)
+	assert.Equal(t, 0, event.TerminalPolicyIndex())
+	assert.Equal(t, "retrypolicy", event.TerminalPolicyType())
+
+	// When
+	attempts := 0
+	event = executor.RunWithResult(func() error {
+		attempts++
+		if attempts < 2 {
+			return testutil.ErrInvalidState
+		}
+		return nil
+	})
+
+	// Then
+	assert.NoError(t, event.Error)
+	assert.Equal(t, 2, event.Attempts())
+	assert.Equal(t, -1, event.TerminalPolicyIndex())
+
+	// When terminal policies are not tracked
+	event = failsafe.NewExecutor[any](rp).RunWithResult(testutil.RunFn(testutil.ErrInvalidState))
+
+	// Then
+	assert.ErrorIs(t, event.Error, retrypolicy.ErrExceeded)
+	assert.Equal(t, -1, event.TerminalPolicyIndex())
+	assert.Equal(t, "", event.TerminalPolicyType())
+}
+
+// Asserts that ConfigJSON describes the configuration of each policy.
+func TestConfigJSON(t *testing.T) {
+	// Given
+	rp := retrypolicy.Builder[any]().WithMaxRetries(3).WithBackoff(10*time.Millisecond, time.Second).Build()
+	cb := circuitbreaker.Builder[any]().WithFailureThresholdRatio(5, 10).WithDelay(time.Minute).Build()
+	to := timeout.With[any](5 * time.Second)
+	executor := failsafe.NewExecutor[any](rp, cb, to)
+
+	// When
+	configJSON, err := executor.ConfigJSON()
+
+	// Then
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"policies": [
+		{"type": "retrypolicy", "config": {
+			"maxRetries": 3, "maxDuration": "0s", "delay": "10ms", "delayMin": "0s", "delayMax": "0s", "maxDelay": "1s",
+			"delayFactor": 2, "fibonacci": false, "jitter": "0s", "jitterFactor": 0, "jitterStrategy": "none",
+			"returnLastFailure": false}},
+		{"type": "circuitbreaker", "config": {
+			"name": "", "delay": "1m0s", "failureThreshold": 5, "failureRateThreshold": 0, "failureThresholdingCapacity": 10,
+			"failureExecutionThreshold": 0, "failureThresholdingPeriod": "0s", "successThreshold": 0,
+			"successThresholdingCapacity": 0, "slowCallDuration": "0s", "slowCallRateThreshold": 0, "initialState": "closed"}},
+		{"type": "timeout", "config": {
+			"timeLimit": "5s", "useReturnedResult": false, "abandonGoroutine": false, "maxAbandoned": 0}}
+	]}`, string(configJSON))
+}
+
+// Asserts that interceptors wrap the composed policies, in order, and can perform the execution again.
+func TestWithInterceptor(t *testing.T) {
+	// Given
+	var calls []string
+	rp := retrypolicy.Builder[string]().WithMaxRetries(1).Build()
+	var attempts atomic.Int32
+	var doneEvent failsafe.ExecutionDoneEvent[string]
+	executor := failsafe.NewExecutor[string](rp).
+		WithInterceptor(func(next failsafe.ExecFn[string]) failsafe.ExecFn[string] {
+			return func(exec failsafe.Execution[string]) (string, error) {
+				calls = append(calls, "outer")
+				return next(exec)
+			}
+		}).
+		WithInterceptor(func(next failsafe.ExecFn[string]) failsafe.ExecFn[string] {
+			return func(exec failsafe.Execution[string]) (string, error) {
+				calls = append(calls, "inner")
+				result, err := next(exec)
+				if err != nil {
+					// Refresh and execute again
+					calls = append(calls, "refresh")
+					return next(exec)
+				}
+				return result, err
+			}
+		}).
+		OnDone(func(e failsafe.ExecutionDoneEvent[string]) {
+			doneEvent = e
+		})
+
+	// When
+	result, err := executor.Get(func() (string, error) {
+		if attempts.Add(1) <= 2 {
+			return "", testutil.ErrInvalidState
+		}
+		return "foo", nil
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", result)
+	assert.Equal(t, []string{"outer", "inner", "refresh"}, calls)
+	assert.Equal(t, 3, doneEvent.Attempts())
+	assert.NoError(t, doneEvent.Error)
+}
+
+// Asserts that an interceptor can replace the result of an execution.
+func TestWithInterceptorReplacesError(t *testing.T) {
+	// Given
+	var success bool
+	executor := failsafe.NewExecutor[string](retrypolicy.WithDefaults[string]()).
+		WithInterceptor(func(next failsafe.ExecFn[string]) failsafe.ExecFn[string] {
+			return func(exec failsafe.Execution[string]) (string, error) {
+				if _, err := next(exec); err != nil {
+					return "default", nil
+				}
+				return "", nil
+			}
+		}).
+		OnSuccess(func(e failsafe.ExecutionDoneEvent[string]) {
+			success = true
+		})
+
+	// When
+	result, err := executor.Get(testutil.GetFn[string]("", testutil.ErrInvalidState))
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "default", result)
+	assert.True(t, success)
+}
+
+// incomparableError is an error whose type is not comparable.
+type incomparableError []error
+
+func (e incomparableError) Error() string {
+	return "incomparable"
+}
+
+func (e incomparableError) Unwrap() []error {
+	return e
+}
+
+// Asserts that an interceptor's error replaces the policies' error when both are of the same incomparable type.
+func TestWithInterceptorReplacesIncomparableError(t *testing.T) {
+	// Given
+	policyErr := incomparableError{testutil.ErrInvalidState}
+	interceptorErr := incomparableError{testutil.ErrInvalidArgument}
+	executor := failsafe.NewExecutor[any](retrypolicy.Builder[any]().WithMaxRetries(0).ReturnLastFailure().Build()).
+		WithTerminalPolicyTracking().
+		WithInterceptor(func(next failsafe.ExecFn[any]) failsafe.ExecFn[any] {
+			return func(exec failsafe.Execution[any]) (any, error) {
+				next(exec)
+				return nil, interceptorErr
+			}
+		})
+
+	// When
+	err := executor.Run(testutil.RunFn(policyErr))
+
+	// Then
+	assert.ErrorIs(t, err, testutil.ErrInvalidArgument)
+}
+
+// Asserts that panics are converted to a PanicError when configured.
+func TestWithPanicsAsErrors(t *testing.T) {
+	// Given
+	var doneEvent failsafe.ExecutionDoneEvent[any]
+	executor := failsafe.NewExecutor[any]().
+		WithPanicsAsErrors().
+		OnDone(func(e failsafe.ExecutionDoneEvent[any]) {
+			doneEvent = e
+		})
+
+	// When
+	err := executor.Run(func() error {
+		panic(testutil.ErrInvalidState)
+	})
+
+	// Then
+	var panicErr *failsafe.PanicError
+	assert.ErrorAs(t, err, &panicErr)
+	assert.ErrorIs(t, err, failsafe.ErrPanic)
+	assert.ErrorIs(t, err, testutil.ErrInvalidState)
+	assert.Equal(t, testutil.ErrInvalidState, panicErr.Value())
+	assert.Contains(t, string(panicErr.Stack()), "TestWithPanicsAsErrors")
+	assert.Equal(t, err, doneEvent.Error)
+}
+
+// Asserts that registering listeners returns a copy of the executor, leaving the original unchanged.
+func TestListenerRegistrationCopiesExecutor(t *testing.T) {
+	// Given
+	var done, success, failure int
+	executor := failsafe.NewExecutor[any](retrypolicy.WithDefaults[any]())
+	derived := executor.
+		OnDone(func(e failsafe.ExecutionDoneEvent[any]) {
+			done++
+		}).
+		OnSuccess(func(e failsafe.ExecutionDoneEvent[any]) {
+			success++
+		}).
+		OnFailure(func(e failsafe.ExecutionDoneEvent[any]) {
+			failure++
+		})
+
+	// When
+	executor.Run(testutil.RunFn(nil))
+	executor.Run(testutil.RunFn(testutil.ErrInvalidState))
+
+	// Then
+	assert.Equal(t, 0, done)
+	assert.Equal(t, 0, success)
+	assert.Equal(t, 0, failure)
+
+	// When
+	derived.Run(testutil.RunFn(nil))
+	derived.Run(testutil.RunFn(testutil.ErrInvalidState))
+
+	// Then
+	assert.Equal(t, 2, done)
+	assert.Equal(t, 1, success)
+	assert.Equal(t, 1, failure)
+}
+
+// Asserts that replacing the policies of a copy of an executor does not affect the original, and vice versa.
+func TestReplacePoliciesOnCopy(t *testing.T) {
+	// Given
+	rp1 := retrypolicy.Builder[any]().WithMaxRetries(1).Build()
+	rp2 := retrypolicy.Builder[any]().WithMaxRetries(2).Build()
+	executor := failsafe.NewExecutor[any](rp1)
+	derived := executor.OnDone(func(e failsafe.ExecutionDoneEvent[any]) {})
+	attempts := func(executor failsafe.Executor[any]) int {
+		return executor.RunWithResult(testutil.RunFn(testutil.ErrInvalidState)).Attempts()
+	}
+
+	// When
+	derived.ReplacePolicies([]failsafe.Policy[any]{rp2})
+
+	// Then
+	assert.Equal(t, 2, attempts(executor))
+	assert.Equal(t, 3, attempts(derived))
+
+	// When
+	executor.ReplacePolicies([]failsafe.Policy[any]{})
+
+	// Then
+	assert.Equal(t, 1, attempts(executor))
+	assert.Equal(t, 3, attempts(derived))
+}
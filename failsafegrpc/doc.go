@@ -0,0 +1,6 @@
+// Package failsafegrpc provides functions that can be used to integrate policies with gRPC.
+//
+// This package is a separate module, so that gRPC is only a dependency of users who need it:
+//
+//	go get github.com/failsafe-go/failsafe-go/failsafegrpc
+package failsafegrpc
@@ -0,0 +1,47 @@
+package failsafegrpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/failsafe-go/failsafe-go"
+)
+
+// UnaryClientInterceptor returns a grpc.UnaryClientInterceptor that performs unary RPCs via the executor. Each attempt
+// is invoked with the execution's context, which is derived from the RPC's context, so that policies such as Timeouts
+// and HedgePolicies can cancel attempts. Since unary RPCs populate their reply rather than returning a result, the
+// executor's policies should handle errors, such as a RetryPolicy from RetryPolicyBuilder.
+//
+// When the reply is a proto.Message, each attempt populates its own copy of the reply, and only the reply from the
+// attempt that the executor returns is merged into the reply, so that concurrent attempts, such as from a HedgePolicy,
+// don't populate the same reply. Other replies are populated by each attempt directly, and should not be used with a
+// HedgePolicy.
+func UnaryClientInterceptor(executor failsafe.Executor[any]) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		replyMsg, ok := reply.(proto.Message)
+		if !ok {
+			return executor.WithContext(ctx).RunWithExecution(func(exec failsafe.Execution[any]) error {
+				return invoker(exec.Context(), method, req, reply, cc, opts...)
+			})
+		}
+
+		result, err := executor.WithContext(ctx).GetWithExecution(func(exec failsafe.Execution[any]) (any, error) {
+			attemptReply := proto.Clone(replyMsg)
+			proto.Reset(attemptReply)
+			if err := invoker(exec.Context(), method, req, attemptReply, cc, opts...); err != nil {
+				return nil, err
+			}
+			return attemptReply, nil
+		})
+		if err != nil {
+			return err
+		}
+		if resultMsg, ok := result.(proto.Message); ok && resultMsg.ProtoReflect().Descriptor() == replyMsg.ProtoReflect().Descriptor() {
+			proto.Reset(replyMsg)
+			proto.Merge(replyMsg, resultMsg)
+		}
+		return nil
+	}
+}
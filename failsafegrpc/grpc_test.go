@@ -0,0 +1,135 @@
+package failsafegrpc
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/circuitbreaker"
+	"github.com/failsafe-go/failsafe-go/hedgepolicy"
+	"github.com/failsafe-go/failsafe-go/timeout"
+)
+
+func TestRetryPolicyWithUnavailableThenSuccess(t *testing.T) {
+	// Given
+	attempts := 0
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		attempts++
+		if attempts < 3 {
+			return status.Error(codes.Unavailable, "unavailable")
+		}
+		*reply.(*string) = "foo"
+		return nil
+	}
+	interceptor := UnaryClientInterceptor(failsafe.NewExecutor[any](RetryPolicyBuilder().Build()))
+
+	// When
+	var reply string
+	err := interceptor(context.Background(), "/test/Method", "req", &reply, nil, invoker)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", reply)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryPolicyWithNonRetryableCode(t *testing.T) {
+	// Given
+	attempts := 0
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		attempts++
+		return status.Error(codes.InvalidArgument, "invalid")
+	}
+	interceptor := UnaryClientInterceptor(failsafe.NewExecutor[any](RetryPolicyBuilder().Build()))
+
+	// When
+	err := interceptor(context.Background(), "/test/Method", "req", nil, nil, invoker)
+
+	// Then
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 1, attempts)
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	// Given
+	cb := circuitbreaker.WithDefaults[any]()
+	cb.Open()
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		assert.Fail(t, "invoker should not be called")
+		return nil
+	}
+	interceptor := UnaryClientInterceptor(failsafe.NewExecutor[any](cb))
+
+	// When
+	err := interceptor(context.Background(), "/test/Method", "req", nil, nil, invoker)
+
+	// Then
+	assert.ErrorIs(t, err, circuitbreaker.ErrOpen)
+}
+
+// Tests that attempts are retried when they exceed a Timeout, and that the attempt's context is canceled.
+func TestRetryPolicyWithTimeout(t *testing.T) {
+	// Given
+	var attemptCtxs []context.Context
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		attemptCtxs = append(attemptCtxs, ctx)
+		<-ctx.Done()
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	rp := RetryPolicyBuilder().ReturnLastFailure().Build()
+	to := timeout.With[any](20 * time.Millisecond)
+	interceptor := UnaryClientInterceptor(failsafe.NewExecutor[any](rp, to))
+
+	// When
+	err := interceptor(context.Background(), "/test/Method", "req", nil, nil, invoker)
+
+	// Then
+	assert.ErrorIs(t, err, timeout.ErrExceeded)
+	assert.Len(t, attemptCtxs, 3)
+	for _, ctx := range attemptCtxs {
+		assert.Error(t, ctx.Err())
+	}
+}
+
+// Tests that concurrent hedged attempts populate their own replies, and that the winning attempt's reply is returned.
+func TestHedgePolicyWithProtoReply(t *testing.T) {
+	// Given
+	var attempts atomic.Int32
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		if attempts.Add(1) == 1 {
+			reply.(*wrapperspb.StringValue).Value = "primary"
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		reply.(*wrapperspb.StringValue).Value = "hedge"
+		return nil
+	}
+	hp := hedgepolicy.BuilderWithDelay[any](10 * time.Millisecond).Build()
+	interceptor := UnaryClientInterceptor(failsafe.NewExecutor[any](hp))
+
+	// When
+	reply := &wrapperspb.StringValue{}
+	err := interceptor(context.Background(), "/test/Method", "req", reply, nil, invoker)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "hedge", reply.Value)
+	assert.Equal(t, int32(2), attempts.Load())
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert.True(t, IsRetryable(status.Error(codes.Unavailable, "")))
+	assert.True(t, IsRetryable(status.Error(codes.DeadlineExceeded, "")))
+	assert.True(t, IsRetryable(status.Error(codes.ResourceExhausted, "")))
+	assert.True(t, IsRetryable(timeout.ErrExceeded))
+	assert.False(t, IsRetryable(status.Error(codes.NotFound, "")))
+	assert.False(t, IsRetryable(nil))
+}
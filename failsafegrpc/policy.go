@@ -0,0 +1,38 @@
+package failsafegrpc
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/failsafe-go/failsafe-go/retrypolicy"
+	"github.com/failsafe-go/failsafe-go/timeout"
+)
+
+// RetryPolicyBuilder returns a retrypolicy.RetryPolicyBuilder that will retry gRPC errors with retryable status codes
+// up to 2 times, by default. See IsRetryable. Additional handling and delay configuration can be added to the resulting
+// builder.
+func RetryPolicyBuilder() retrypolicy.RetryPolicyBuilder[any] {
+	return retrypolicy.Builder[any]().
+		HandleIf(func(_ any, err error) bool {
+			return IsRetryable(err)
+		})
+}
+
+// IsRetryable returns whether the err is a gRPC error with an Unavailable, DeadlineExceeded, or ResourceExhausted status
+// code, or a timeout.ErrExceeded error from a Timeout attempt.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, timeout.ErrExceeded) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
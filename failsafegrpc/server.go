@@ -0,0 +1,71 @@
+package failsafegrpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/adaptivelimiter"
+	"github.com/failsafe-go/failsafe-go/adaptiveratelimiter"
+	"github.com/failsafe-go/failsafe-go/bulkhead"
+	"github.com/failsafe-go/failsafe-go/loadshedder"
+	"github.com/failsafe-go/failsafe-go/ratelimiter"
+)
+
+// UnaryServerInterceptor returns a grpc.UnaryServerInterceptor that handles unary RPCs via the executor, such as with
+// load protection policies like a Bulkhead, RateLimiter, or AdaptiveLimiter. The handler is called with the execution's
+// context, which is derived from the RPC's context. Rejections by load protection policies are returned as errors with
+// a ResourceExhausted status code. See IsRejection.
+func UnaryServerInterceptor(executor failsafe.Executor[any]) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := executor.WithContext(ctx).GetWithExecution(func(exec failsafe.Execution[any]) (any, error) {
+			return handler(exec.Context(), req)
+		})
+		return resp, rejectionToStatus(err)
+	}
+}
+
+// StreamServerInterceptor returns a grpc.StreamServerInterceptor that handles streaming RPCs via the executor, such as
+// with load protection policies like a Bulkhead, RateLimiter, or AdaptiveLimiter. The handler is called with a stream
+// whose context is the execution's context, which is derived from the stream's context. Rejections by load protection
+// policies are returned as errors with a ResourceExhausted status code. See IsRejection.
+func StreamServerInterceptor(executor failsafe.Executor[any]) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := executor.WithContext(ss.Context()).RunWithExecution(func(exec failsafe.Execution[any]) error {
+			return handler(srv, &serverStream{ServerStream: ss, ctx: exec.Context()})
+		})
+		return rejectionToStatus(err)
+	}
+}
+
+// IsRejection returns whether the err is a rejection by a load protection policy, including a Bulkhead, RateLimiter,
+// AdaptiveRateLimiter, AdaptiveLimiter, or LoadShedder.
+func IsRejection(err error) bool {
+	return errors.Is(err, bulkhead.ErrFull) ||
+		errors.Is(err, ratelimiter.ErrExceeded) ||
+		errors.Is(err, adaptiveratelimiter.ErrExceeded) ||
+		errors.Is(err, adaptivelimiter.ErrLimitExceeded) ||
+		errors.Is(err, loadshedder.ErrOverloaded)
+}
+
+// rejectionToStatus converts rejection errors to errors with a ResourceExhausted status code, else returns the err.
+func rejectionToStatus(err error) error {
+	if IsRejection(err) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return err
+}
+
+// serverStream is a grpc.ServerStream whose Context is an execution's context.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
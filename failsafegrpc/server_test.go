@@ -0,0 +1,92 @@
+package failsafegrpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/bulkhead"
+	"github.com/failsafe-go/failsafe-go/ratelimiter"
+)
+
+type testContextKey struct{}
+
+// Tests that a unary RPC that is rejected by a Bulkhead returns a ResourceExhausted status.
+func TestUnaryServerInterceptorWithBulkhead(t *testing.T) {
+	// Given
+	bh := bulkhead.With[any](1)
+	bh.TryAcquirePermit() // bulkhead should be full
+	interceptor := UnaryServerInterceptor(failsafe.NewExecutor[any](bh))
+	handler := func(ctx context.Context, req any) (any, error) {
+		assert.Fail(t, "handler should not be called")
+		return nil, nil
+	}
+
+	// When
+	resp, err := interceptor(context.Background(), "req", &grpc.UnaryServerInfo{}, handler)
+
+	// Then
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+// Tests that a unary RPC's handler is called with a context derived from the RPC's context, and that handler errors
+// that are not rejections are returned as-is.
+func TestUnaryServerInterceptorWithHandlerError(t *testing.T) {
+	// Given
+	interceptor := UnaryServerInterceptor(failsafe.NewExecutor[any](ratelimiter.Smooth[any](1, time.Minute)))
+	ctx := context.WithValue(context.Background(), testContextKey{}, "value")
+	handlerErr := status.Error(codes.NotFound, "not found")
+
+	// When
+	_, err := interceptor(ctx, "req", &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		assert.Equal(t, "value", ctx.Value(testContextKey{}))
+		return nil, handlerErr
+	})
+
+	// Then
+	assert.Equal(t, handlerErr, err)
+}
+
+// Tests that a streaming RPC that is rejected by a RateLimiter returns a ResourceExhausted status.
+func TestStreamServerInterceptorWithRateLimiter(t *testing.T) {
+	// Given
+	rl := ratelimiter.Smooth[any](1, time.Minute)
+	interceptor := StreamServerInterceptor(failsafe.NewExecutor[any](rl))
+	ctx := context.WithValue(context.Background(), testContextKey{}, "value")
+	stream := &testServerStream{ctx: ctx}
+	handled := 0
+	handler := func(srv any, stream grpc.ServerStream) error {
+		handled++
+		assert.Equal(t, "value", stream.Context().Value(testContextKey{}))
+		return nil
+	}
+
+	// When
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{}, handler)
+
+	// Then
+	assert.NoError(t, err)
+
+	// When
+	err = interceptor(nil, stream, &grpc.StreamServerInfo{}, handler)
+
+	// Then
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, 1, handled)
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
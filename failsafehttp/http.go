@@ -12,7 +12,8 @@ type roundTripper struct {
 }
 
 // NewRoundTripper creates and returns a new http.RoundTripper that will perform failsafe round trips via the executor
-// and innerRoundTripper. If innerRoundTripper is nil, http.DefaultTransport will be used.
+// and innerRoundTripper. If innerRoundTripper is nil, http.DefaultTransport will be used. When a request is retried or
+// hedged, its body is rewound via the request's GetBody func, if any.
 func NewRoundTripper(executor failsafe.Executor[*http.Response], innerRoundTripper http.RoundTripper) http.RoundTripper {
 	if innerRoundTripper == nil {
 		innerRoundTripper = http.DefaultTransport
@@ -25,7 +26,11 @@ func NewRoundTripper(executor failsafe.Executor[*http.Response], innerRoundTripp
 
 func (f *roundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
 	return f.executor.GetWithExecution(func(exec failsafe.Execution[*http.Response]) (*http.Response, error) {
-		return f.next.RoundTrip(request.WithContext(exec.Context()))
+		req, err := requestForAttempt(exec, request)
+		if err != nil {
+			return nil, err
+		}
+		return f.next.RoundTrip(req)
 	})
 }
 
@@ -45,6 +50,25 @@ func NewRequest(executor failsafe.Executor[*http.Response], request *http.Reques
 
 func (c *Request) Do() (*http.Response, error) {
 	return c.executor.GetWithExecution(func(exec failsafe.Execution[*http.Response]) (*http.Response, error) {
-		return c.client.Do(c.request.WithContext(exec.Context()))
+		req, err := requestForAttempt(exec, c.request)
+		if err != nil {
+			return nil, err
+		}
+		return c.client.Do(req)
 	})
 }
+
+// requestForAttempt returns a copy of the request with the exec's context. For attempts after the first, and for
+// hedges, the request's body is rewound via GetBody, since the original body may have already been consumed.
+func requestForAttempt(exec failsafe.Execution[*http.Response], request *http.Request) (*http.Request, error) {
+	req := request.WithContext(exec.Context())
+	if (exec.IsFirstAttempt() && !exec.IsHedge()) || request.GetBody == nil || request.Body == nil || request.Body == http.NoBody {
+		return req, nil
+	}
+	body, err := request.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = body
+	return req, nil
+}
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync/atomic"
 	"syscall"
 	"testing"
 	"time"
@@ -142,6 +144,44 @@ func TestTimeout(t *testing.T) {
 		1, 1, timeout.ErrExceeded)
 }
 
+// Tests that a request which exceeds a header timeout is retried.
+func TestTimeoutPoliciesWithHeaderTimeout(t *testing.T) {
+	// Given
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, request *http.Request) {
+		if requests.Add(1) == 1 {
+			// Delay the first response beyond the header timeout
+			select {
+			case <-time.After(time.Second):
+			case <-request.Context().Done():
+				return
+			}
+		}
+		fmt.Fprintf(w, "foo")
+	}))
+	defer server.Close()
+	policies := TimeoutPolicies(RetryPolicyBuilder().Build(), 50*time.Millisecond, time.Second)
+	executor := failsafe.NewExecutor[*http.Response](policies...)
+
+	// When / Then
+	testRequestSuccess(t, server.URL, executor,
+		2, 2, 200, "foo")
+}
+
+// Tests that a request which exceeds an overall timeout is not retried.
+func TestTimeoutPoliciesWithOverallTimeout(t *testing.T) {
+	// Given
+	server := testutil.MockDelayedResponse(200, "bad", time.Second)
+	defer server.Close()
+	rp := RetryPolicyBuilder().WithMaxRetries(-1).ReturnLastFailure().Build()
+	policies := TimeoutPolicies(rp, 100*time.Millisecond, 250*time.Millisecond)
+	executor := failsafe.NewExecutor[*http.Response](policies...)
+
+	// When / Then
+	testRequestFailureError(t, server.URL, executor,
+		3, 3, timeout.ErrExceeded)
+}
+
 // Tests that a failsafe roundtripper's requests are canceled when an external context is canceled.
 func TestCancelWithContext(t *testing.T) {
 	// Given
@@ -169,6 +209,65 @@ func TestCancelWithTimeout(t *testing.T) {
 		1, 1, timeout.ErrExceeded)
 }
 
+// Tests that a request's body is rewound when the request is retried via a failsafe roundtripper.
+func TestRetryPolicyRewindsRequestBody(t *testing.T) {
+	// Given
+	var bodies []string
+	inner := roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(request.Body)
+		bodies = append(bodies, string(body))
+		statusCode := http.StatusServiceUnavailable
+		if len(bodies) == 3 {
+			statusCode = http.StatusOK
+		}
+		return &http.Response{StatusCode: statusCode, Body: http.NoBody}, nil
+	})
+	executor := failsafe.NewExecutor[*http.Response](RetryPolicyBuilder().Build())
+	req, _ := http.NewRequest(http.MethodPost, "http://failsafe-go.dev", bytes.NewBufferString("foo"))
+
+	// When
+	resp, err := NewRoundTripper(executor, inner).RoundTrip(req)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"foo", "foo", "foo"}, bodies)
+}
+
+// Tests that DelayFunc delays according to a Retry-After header that contains an HTTP date.
+func TestDelayFuncWithRetryAfterDate(t *testing.T) {
+	// Given
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var delay time.Duration
+	rp := RetryPolicyBuilder().
+		OnRetryScheduled(func(e failsafe.ExecutionScheduledEvent[*http.Response]) {
+			delay = e.Delay
+			cancel()
+		}).
+		Build()
+	inner := roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		header := http.Header{}
+		header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+		return &http.Response{StatusCode: http.StatusServiceUnavailable, Header: header, Body: http.NoBody}, nil
+	})
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://failsafe-go.dev", nil)
+
+	// When
+	_, err := NewRoundTripper(failsafe.NewExecutor[*http.Response](rp).WithContext(ctx), inner).RoundTrip(req)
+
+	// Then
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Greater(t, delay, 58*time.Second)
+	assert.LessOrEqual(t, delay, time.Minute)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
 func testRequestSuccess(t *testing.T, url string, executor failsafe.Executor[*http.Response], expectedAttempts int, expectedExecutions int, expectedStatus int, expectedResult any, then ...func()) {
 	testRequest(t, url, executor, expectedAttempts, expectedExecutions, expectedStatus, expectedResult, nil, true, then...)
 }
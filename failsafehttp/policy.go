@@ -10,6 +10,7 @@ import (
 
 	"github.com/failsafe-go/failsafe-go"
 	"github.com/failsafe-go/failsafe-go/retrypolicy"
+	"github.com/failsafe-go/failsafe-go/timeout"
 )
 
 var (
@@ -61,9 +62,12 @@ func RetryPolicyBuilder() retrypolicy.RetryPolicyBuilder[*http.Response] {
 		WithDelayFunc(DelayFunc())
 }
 
-// DelayFunc returns a failsafe.DelayFunc that delays according to an http.Response Retry-After header. This can be used
-// as a delay in a RetryPolicy or a CircuitBreaker.
+// DelayFunc returns a failsafe.DelayFunc that delays according to an http.Response Retry-After header, which may
+// contain either a number of seconds or an HTTP date. If the response has no Retry-After header, the DelayFunc delays
+// according to any failsafe.DelayableError that was returned. This can be used as a delay in a RetryPolicy or a
+// CircuitBreaker.
 func DelayFunc() failsafe.DelayFunc[*http.Response] {
+	errorDelayFunc := failsafe.ErrorDelayFunc[*http.Response]()
 	return func(exec failsafe.ExecutionAttempt[*http.Response]) time.Duration {
 		resp := exec.LastResult()
 		if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
@@ -71,9 +75,33 @@ func DelayFunc() failsafe.DelayFunc[*http.Response] {
 				if seconds, err := strconv.Atoi(header[0]); err == nil {
 					return time.Second * time.Duration(seconds)
 				}
+				if date, err := http.ParseTime(header[0]); err == nil {
+					return max(0, time.Until(date))
+				}
 			}
 		}
 
-		return -1
+		return errorDelayFunc(exec)
+	}
+}
+
+// TimeoutPolicies returns policies that apply separate response header and overall timeouts to HTTP requests, composed
+// around the retryPolicy. The headerTimeLimit bounds each round trip attempt until response headers are received, and is
+// composed inside the retryPolicy so that attempts which exceed it can be retried. The overallTimeLimit bounds all
+// attempts until response headers are received, including any retries and delays between them, and is composed outside
+// the retryPolicy so that exceeding it is not retried. Neither time limit bounds reading the response body after the
+// headers are received, which callers should bound separately, such as via a context deadline. The resulting policies
+// compose as:
+//
+//	Timeout(overallTimeLimit) -> RetryPolicy -> Timeout(headerTimeLimit)
+//
+// Additional policies can be composed around or inside of the resulting policies. The retryPolicy should handle
+// timeout.ErrExceeded errors for header timeouts to be retried, which a RetryPolicy from RetryPolicyBuilder does by
+// default.
+func TimeoutPolicies(retryPolicy retrypolicy.RetryPolicy[*http.Response], headerTimeLimit time.Duration, overallTimeLimit time.Duration) []failsafe.Policy[*http.Response] {
+	return []failsafe.Policy[*http.Response]{
+		timeout.With[*http.Response](overallTimeLimit),
+		retryPolicy,
+		timeout.With[*http.Response](headerTimeLimit),
 	}
 }
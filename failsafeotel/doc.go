@@ -0,0 +1,6 @@
+// Package failsafeotel provides a failsafe.Tracer implementation that traces executions with OpenTelemetry.
+//
+// This package is a separate module, so that OpenTelemetry is only a dependency of users who need it:
+//
+//	go get github.com/failsafe-go/failsafe-go/failsafeotel
+package failsafeotel
@@ -0,0 +1,56 @@
+package failsafeotel
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/failsafe-go/failsafe-go"
+)
+
+// NewTracer returns a failsafe.Tracer that starts OpenTelemetry spans via the tracer. This can be used with
+// failsafe.Executor.WithTracer.
+func NewTracer(tracer trace.Tracer) failsafe.Tracer {
+	return &otelTracer{tracer: tracer}
+}
+
+type otelTracer struct {
+	tracer trace.Tracer
+}
+
+func (t *otelTracer) StartSpan(ctx context.Context, name string) (context.Context, failsafe.Span) {
+	ctx, span := t.tracer.Start(ctx, name)
+	return ctx, &otelSpan{span: span}
+}
+
+type otelSpan struct {
+	span trace.Span
+}
+
+func (s *otelSpan) SetAttribute(key string, value any) {
+	switch v := value.(type) {
+	case bool:
+		s.span.SetAttributes(attribute.Bool(key, v))
+	case int:
+		s.span.SetAttributes(attribute.Int(key, v))
+	case string:
+		s.span.SetAttributes(attribute.String(key, v))
+	default:
+		s.span.SetAttributes(attribute.String(key, fmt.Sprint(v)))
+	}
+}
+
+func (s *otelSpan) AddEvent(name string) {
+	s.span.AddEvent(name)
+}
+
+func (s *otelSpan) End(err error) {
+	if err != nil {
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	s.span.End()
+}
@@ -0,0 +1,62 @@
+package failsafeotel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/retrypolicy"
+	"github.com/failsafe-go/failsafe-go/timeout"
+)
+
+// Tests that an execution's span contains a child span per attempt, and records policy outcomes as events.
+func TestTracer(t *testing.T) {
+	// Given
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	rp := retrypolicy.Builder[any]().WithMaxRetries(1).ReturnLastFailure().Build()
+	to := timeout.With[any](10 * time.Millisecond)
+	executor := failsafe.NewExecutor[any](rp, to).WithTracer(NewTracer(provider.Tracer("test")))
+	var attemptSpanIDs []trace.SpanID
+
+	// When
+	err := executor.RunWithExecution(func(exec failsafe.Execution[any]) error {
+		attemptSpanIDs = append(attemptSpanIDs, trace.SpanContextFromContext(exec.Context()).SpanID())
+		<-exec.Canceled()
+		return context.Canceled
+	})
+
+	// Then
+	assert.ErrorIs(t, err, timeout.ErrExceeded)
+	spans := recorder.Ended()
+	assert.Len(t, spans, 3)
+	execSpan := spans[2]
+	assert.Equal(t, failsafe.SpanExecution, execSpan.Name())
+	assert.Equal(t, codes.Error, execSpan.Status().Code)
+	assert.Contains(t, execSpan.Attributes(), attribute.Int("failsafe.attempts", 2))
+	assert.Contains(t, execSpan.Attributes(), attribute.Bool("failsafe.success", false))
+	var events []string
+	for _, event := range execSpan.Events() {
+		if event.Name != "exception" {
+			events = append(events, event.Name)
+		}
+	}
+	assert.Equal(t, []string{"timeout exceeded", "retry scheduled", "timeout exceeded"}, events)
+
+	for i, attemptSpan := range spans[:2] {
+		assert.Equal(t, failsafe.SpanAttempt, attemptSpan.Name())
+		assert.Equal(t, execSpan.SpanContext().SpanID(), attemptSpan.Parent().SpanID())
+		assert.Equal(t, attemptSpanIDs[i], attemptSpan.SpanContext().SpanID())
+		assert.Contains(t, attemptSpan.Attributes(), attribute.Int("failsafe.attempt", i+1))
+		assert.Contains(t, attemptSpan.Attributes(), attribute.Bool("failsafe.retry", i > 0))
+		assert.Contains(t, attemptSpan.Attributes(), attribute.Bool("failsafe.hedge", false))
+	}
+}
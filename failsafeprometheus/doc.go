@@ -0,0 +1,10 @@
+// Package failsafeprometheus provides a failsafe.Metrics implementation that publishes metrics to Prometheus.
+//
+// This package is a separate module, so that the Prometheus client is only a dependency of users who need it:
+//
+//	go get github.com/failsafe-go/failsafe-go/failsafeprometheus
+//
+// Exemplars that link metrics to traces are read from OpenTelemetry span contexts, so this module depends on the
+// OpenTelemetry trace API. Spans are typically started by configuring the failsafeotel Tracer via
+// failsafe.Executor.WithTracer, or by OpenTelemetry instrumentation that provides the Executor's context.
+package failsafeprometheus
@@ -0,0 +1,190 @@
+package failsafeprometheus
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/failsafe-go/failsafe-go"
+)
+
+// help contains the help text for metrics that are published by failsafe.
+var help = map[string]string{
+	failsafe.MetricExecutions:                "Completed executions by outcome.",
+	failsafe.MetricExecutionDuration:         "Execution durations in seconds, including any retries and delays.",
+	failsafe.MetricExecutionAttempts:         "Attempts made per execution.",
+	failsafe.MetricRetries:                   "Retries scheduled by RetryPolicies.",
+	failsafe.MetricHedges:                    "Hedges started by HedgePolicies.",
+	failsafe.MetricCircuitBreakerTransitions: "CircuitBreaker state transitions caused by executions, by new state.",
+	failsafe.MetricCircuitBreakerState:       "CircuitBreaker state, where 0 is closed, 1 is open, and 2 is half-open.",
+	failsafe.MetricTimeoutsExceeded:          "Attempts that exceeded a Timeout.",
+	failsafe.MetricRejections:                "Executions rejected by a policy, by policy type.",
+}
+
+// Metrics is a failsafe.Metrics that publishes metrics to Prometheus. Collectors are registered with the registerer the
+// first time that each metric is published. Publishing a metric panics if its collector cannot be registered, such as
+// when a different collector with the same name is already registered, as prometheus.MustRegister does.
+//
+// Metrics is also a failsafe.ContextMetrics, which attaches exemplars to the counters and histograms that are published
+// for an execution whose context contains a valid OpenTelemetry span context, such as one started by the failsafeotel
+// Tracer. Exemplars have "trace_id" and "span_id" labels, linking the metrics to the execution's trace, and are exposed
+// when metrics are scraped in the OpenMetrics format.
+type Metrics struct {
+	registerer  prometheus.Registerer
+	constLabels prometheus.Labels
+
+	mtx        sync.Mutex
+	counters   map[string]*prometheus.CounterVec
+	gauges     map[string]*prometheus.GaugeVec
+	histograms map[string]*prometheus.HistogramVec
+}
+
+var _ failsafe.ContextMetrics = &Metrics{}
+
+// NewMetrics returns a new Metrics that registers collectors with the registerer. The constLabels, which may be nil,
+// are added to every metric, such as to identify the Executor that metrics are published for.
+func NewMetrics(registerer prometheus.Registerer, constLabels prometheus.Labels) *Metrics {
+	return &Metrics{
+		registerer:  registerer,
+		constLabels: constLabels,
+		counters:    make(map[string]*prometheus.CounterVec),
+		gauges:      make(map[string]*prometheus.GaugeVec),
+		histograms:  make(map[string]*prometheus.HistogramVec),
+	}
+}
+
+func (m *Metrics) IncrementCounter(name string, labels map[string]string) {
+	m.counter(name, labels).With(labels).Inc()
+}
+
+func (m *Metrics) SetGauge(name string, value float64, labels map[string]string) {
+	m.gauge(name, labels).With(labels).Set(value)
+}
+
+func (m *Metrics) ObserveHistogram(name string, value float64, labels map[string]string) {
+	m.histogram(name, labels).With(labels).Observe(value)
+}
+
+// WithContext returns a failsafe.Metrics that attaches an exemplar with the trace and span IDs of any valid span context
+// in the ctx to counters and histograms. If the ctx does not contain a valid span context, m is returned.
+func (m *Metrics) WithContext(ctx context.Context) failsafe.Metrics {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.IsValid() {
+		return m
+	}
+	return &exemplarMetrics{
+		Metrics: m,
+		exemplar: prometheus.Labels{
+			"trace_id": spanCtx.TraceID().String(),
+			"span_id":  spanCtx.SpanID().String(),
+		},
+	}
+}
+
+// counter returns the counter with the name, registering it if needed.
+func (m *Metrics) counter(name string, labels map[string]string) *prometheus.CounterVec {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	counter, ok := m.counters[name]
+	if !ok {
+		counter = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        name,
+			Help:        help[name],
+			ConstLabels: m.constLabels,
+		}, labelNames(labels))
+		counter = registerOrExisting(m.registerer, counter)
+		m.counters[name] = counter
+	}
+	return counter
+}
+
+// gauge returns the gauge with the name, registering it if needed.
+func (m *Metrics) gauge(name string, labels map[string]string) *prometheus.GaugeVec {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	gauge, ok := m.gauges[name]
+	if !ok {
+		gauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        name,
+			Help:        help[name],
+			ConstLabels: m.constLabels,
+		}, labelNames(labels))
+		gauge = registerOrExisting(m.registerer, gauge)
+		m.gauges[name] = gauge
+	}
+	return gauge
+}
+
+// histogram returns the histogram with the name, registering it if needed.
+func (m *Metrics) histogram(name string, labels map[string]string) *prometheus.HistogramVec {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	histogram, ok := m.histograms[name]
+	if !ok {
+		buckets := prometheus.DefBuckets
+		if name == failsafe.MetricExecutionAttempts {
+			buckets = prometheus.LinearBuckets(1, 1, 10)
+		}
+		histogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:        name,
+			Help:        help[name],
+			ConstLabels: m.constLabels,
+			Buckets:     buckets,
+		}, labelNames(labels))
+		histogram = registerOrExisting(m.registerer, histogram)
+		m.histograms[name] = histogram
+	}
+	return histogram
+}
+
+// exemplarMetrics is a failsafe.Metrics that attaches an exemplar to the counters and histograms of a Metrics.
+type exemplarMetrics struct {
+	*Metrics
+	exemplar prometheus.Labels
+}
+
+func (m *exemplarMetrics) IncrementCounter(name string, labels map[string]string) {
+	counter := m.counter(name, labels).With(labels)
+	if adder, ok := counter.(prometheus.ExemplarAdder); ok {
+		adder.AddWithExemplar(1, m.exemplar)
+	} else {
+		counter.Inc()
+	}
+}
+
+func (m *exemplarMetrics) ObserveHistogram(name string, value float64, labels map[string]string) {
+	histogram := m.histogram(name, labels).With(labels)
+	if observer, ok := histogram.(prometheus.ExemplarObserver); ok {
+		observer.ObserveWithExemplar(value, m.exemplar)
+	} else {
+		histogram.Observe(value)
+	}
+}
+
+// labelNames returns the sorted names of the labels.
+func labelNames(labels map[string]string) []string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// registerOrExisting registers the collector with the registerer, returning any existing collector that was already
+// registered with the same descriptor, such as by another Metrics that uses the same registerer. Panics if the collector
+// cannot otherwise be registered, as prometheus.MustRegister does.
+func registerOrExisting[C prometheus.Collector](registerer prometheus.Registerer, collector C) C {
+	if err := registerer.Register(collector); err != nil {
+		if are, ok := err.(prometheus.AlreadyRegisteredError); ok {
+			if existing, ok := are.ExistingCollector.(C); ok {
+				return existing
+			}
+		}
+		panic(err)
+	}
+	return collector
+}
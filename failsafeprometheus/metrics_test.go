@@ -0,0 +1,172 @@
+package failsafeprometheus
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/circuitbreaker"
+	"github.com/failsafe-go/failsafe-go/retrypolicy"
+)
+
+func TestMetrics(t *testing.T) {
+	// Given
+	registry := prometheus.NewRegistry()
+	rp := retrypolicy.Builder[any]().WithMaxRetries(2).Build()
+	cb := circuitbreaker.Builder[any]().WithFailureThreshold(3).Build()
+	executor := failsafe.NewExecutor[any](rp, cb).WithMetrics(NewMetrics(registry, prometheus.Labels{"executor": "test"}))
+
+	// When
+	err := executor.Run(func() error {
+		return errors.New("test")
+	})
+
+	// Then
+	assert.Error(t, err)
+	count, err := testutil.GatherAndCount(registry,
+		failsafe.MetricExecutions,
+		failsafe.MetricExecutionDuration,
+		failsafe.MetricExecutionAttempts,
+		failsafe.MetricRetries,
+		failsafe.MetricCircuitBreakerTransitions,
+		failsafe.MetricCircuitBreakerState)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, count)
+
+	families, err := registry.Gather()
+	assert.NoError(t, err)
+	values := make(map[string]float64)
+	for _, family := range families {
+		metric := family.GetMetric()[0]
+		assert.Equal(t, "test", metric.GetLabel()[0].GetValue())
+		switch {
+		case metric.Counter != nil:
+			values[family.GetName()] = metric.Counter.GetValue()
+		case metric.Gauge != nil:
+			values[family.GetName()] = metric.Gauge.GetValue()
+		case metric.Histogram != nil:
+			values[family.GetName()] = metric.Histogram.GetSampleSum()
+		}
+	}
+	assert.Equal(t, float64(1), values[failsafe.MetricExecutions])
+	assert.Equal(t, float64(3), values[failsafe.MetricExecutionAttempts])
+	assert.Equal(t, float64(2), values[failsafe.MetricRetries])
+	assert.Equal(t, float64(1), values[failsafe.MetricCircuitBreakerTransitions])
+	assert.Equal(t, float64(circuitbreaker.OpenState), values[failsafe.MetricCircuitBreakerState])
+}
+
+// Asserts that Metrics which share a registerer also share collectors.
+func TestMetricsWithSharedRegisterer(t *testing.T) {
+	// Given
+	registry := prometheus.NewRegistry()
+	metrics1 := NewMetrics(registry, nil)
+	metrics2 := NewMetrics(registry, nil)
+
+	// When
+	metrics1.IncrementCounter(failsafe.MetricRetries, nil)
+	metrics2.IncrementCounter(failsafe.MetricRetries, nil)
+
+	// Then
+	families, err := registry.Gather()
+	assert.NoError(t, err)
+	assert.Len(t, families, 1)
+	assert.Equal(t, float64(2), families[0].GetMetric()[0].Counter.GetValue())
+}
+
+// Asserts that publishing a metric panics if its collector conflicts with one that is already registered.
+func TestMetricsWithConflictingCollector(t *testing.T) {
+	// Given
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: failsafe.MetricRetries,
+		Help: "Conflicting retries.",
+	}, []string{"other"}))
+	metrics := NewMetrics(registry, nil)
+
+	// When / Then
+	assert.Panics(t, func() {
+		metrics.IncrementCounter(failsafe.MetricRetries, map[string]string{"policy": "RetryPolicy"})
+	})
+}
+
+// Asserts that exemplars with the trace and span IDs are attached to counters and histograms when an execution's context
+// contains a span context.
+func TestMetricsWithExemplars(t *testing.T) {
+	// Given
+	registry := prometheus.NewRegistry()
+	cb := circuitbreaker.Builder[any]().WithFailureThreshold(1).Build()
+	executor := failsafe.NewExecutor[any](cb).WithMetrics(NewMetrics(registry, nil))
+	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		SpanID:     trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), spanCtx)
+
+	// When
+	err := executor.WithContext(ctx).Run(func() error {
+		return errors.New("test")
+	})
+
+	// Then
+	assert.Error(t, err)
+	families, err := registry.Gather()
+	assert.NoError(t, err)
+	exemplars := make(map[string]*dto.Exemplar)
+	for _, family := range families {
+		metric := family.GetMetric()[0]
+		switch {
+		case metric.Counter != nil:
+			exemplars[family.GetName()] = metric.Counter.GetExemplar()
+		case metric.Histogram != nil:
+			for _, bucket := range metric.Histogram.GetBucket() {
+				if bucket.GetExemplar() != nil {
+					exemplars[family.GetName()] = bucket.GetExemplar()
+				}
+			}
+		}
+	}
+	for _, name := range []string{
+		failsafe.MetricExecutions,
+		failsafe.MetricExecutionDuration,
+		failsafe.MetricCircuitBreakerTransitions,
+	} {
+		exemplar := exemplars[name]
+		if assert.NotNil(t, exemplar, name) {
+			labels := make(map[string]string)
+			for _, label := range exemplar.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			assert.Equal(t, spanCtx.TraceID().String(), labels["trace_id"], name)
+			assert.Equal(t, spanCtx.SpanID().String(), labels["span_id"], name)
+		}
+	}
+}
+
+// Asserts that exemplars are not attached when an execution's context does not contain a span context.
+func TestMetricsWithoutSpanContext(t *testing.T) {
+	// Given
+	registry := prometheus.NewRegistry()
+	executor := failsafe.NewExecutor[any]().WithMetrics(NewMetrics(registry, nil))
+
+	// When
+	executor.Run(func() error {
+		return nil
+	})
+
+	// Then
+	families, err := registry.Gather()
+	assert.NoError(t, err)
+	for _, family := range families {
+		if counter := family.GetMetric()[0].Counter; counter != nil {
+			assert.Nil(t, counter.GetExemplar())
+		}
+	}
+}
@@ -0,0 +1,6 @@
+// Package failsaferedis provides Redis-backed stores for failsafe-go policies.
+//
+// This package is a separate module, so that the Redis client is only a dependency of users who need it:
+//
+//	go get github.com/failsafe-go/failsafe-go/failsaferedis
+package failsaferedis
@@ -0,0 +1,37 @@
+package failsaferedis
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/failsafe-go/failsafe-go/ratelimiter"
+)
+
+// Increments a key, setting its expiration if the key is new.
+var incrementScript = redis.NewScript(`
+local count = redis.call("INCRBY", KEYS[1], ARGV[1])
+if count == tonumber(ARGV[1]) then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return count
+`)
+
+type rateLimiterStore struct {
+	client redis.Scripter
+}
+
+var _ ratelimiter.Store = &rateLimiterStore{}
+
+// NewRateLimiterStore returns a ratelimiter.Store that stores permit counts in Redis via the client, allowing a rate limit
+// to be enforced across processes that share the Redis instance. See ratelimiter.RateLimiterBuilder.WithStore.
+func NewRateLimiterStore(client redis.Scripter) ratelimiter.Store {
+	return &rateLimiterStore{client: client}
+}
+
+func (s *rateLimiterStore) IncrementBy(ctx context.Context, key string, permits int, ttl time.Duration) (int64, error) {
+	// Expire keys after at least 1 millisecond, since PEXPIRE with 0 deletes them
+	ttlMillis := max(ttl.Milliseconds(), 1)
+	return incrementScript.Run(ctx, s.client, []string{key}, permits, ttlMillis).Int64()
+}
@@ -0,0 +1,52 @@
+package failsaferedis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go/ratelimiter"
+)
+
+func TestRateLimiterStoreIncrementBy(t *testing.T) {
+	// Given
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	store := NewRateLimiterStore(client)
+	ctx := context.Background()
+
+	// When / Then
+	count, err := store.IncrementBy(ctx, "key", 2, time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	count, err = store.IncrementBy(ctx, "key", 1, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+	assert.Equal(t, time.Second, server.TTL("key"))
+
+	// Expire the key
+	server.FastForward(time.Second)
+	count, err = store.IncrementBy(ctx, "key", 1, time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+// Asserts that rate limiters that share a Redis store share a rate limit.
+func TestRateLimiterSharedAcrossStore(t *testing.T) {
+	// Given
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	store := NewRateLimiterStore(client)
+	limiter1 := ratelimiter.BurstyBuilder[any](2, time.Hour).WithStore(store, "test").Build()
+	limiter2 := ratelimiter.BurstyBuilder[any](2, time.Hour).WithStore(store, "test").Build()
+
+	// When / Then
+	assert.True(t, limiter1.TryAcquirePermit())
+	assert.True(t, limiter2.TryAcquirePermit())
+	assert.False(t, limiter1.TryAcquirePermit())
+	assert.False(t, limiter2.TryAcquirePermit())
+}
@@ -1,7 +1,11 @@
 package fallback
 
 import (
+	"sync"
+	"time"
+
 	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/internal/util"
 	"github.com/failsafe-go/failsafe-go/policy"
 )
 
@@ -30,20 +34,72 @@ type FallbackBuilder[R any] interface {
 	// the execution result and error returned by the Fallback.
 	OnFallbackExecuted(listener func(event failsafe.ExecutionDoneEvent[R])) FallbackBuilder[R]
 
+	// WithHandlerFor registers the fallbackFunc to handle failures that the matcher matches. Multiple handlers can be
+	// registered to handle different failures differently, such as by returning a cached result for timeouts and a default
+	// result for not found errors. When a failure is handled, matchers are checked in the order they were registered, and
+	// only the fallbackFunc for the first matching handler is called. If no handler matches, any fallback funcs that the
+	// builder was created with are called instead, and if there are none, the failure is returned as is. The matcher can be
+	// any predicate, such as one from failsafe.ErrorMessageMatcher.
+	//
+	// Handlers are only called for failures that the Fallback handles, which by default are any errors.
+	WithHandlerFor(matcher func(R, error) bool, fallbackFunc func(exec failsafe.Execution[R]) (R, error)) FallbackBuilder[R]
+
+	// WithDetachedContext configures the fallback func to be provided an execution whose context is detached from the
+	// cancellation of the execution's context, and is instead bounded by the timeout. This allows the fallback func to
+	// perform its own calls after the execution's context is canceled, such as by a deadline. Values from the execution's
+	// context are propagated to the detached context. A timeout of 0 means the detached context has no timeout. When
+	// configured, a fallback result is returned even if the execution was canceled.
+	WithDetachedContext(timeout time.Duration) FallbackBuilder[R]
+
+	// WithLastKnownGood configures the Fallback to remember the most recent successful result for each key returned by the
+	// keyFunc, and to return it when an execution with the same key fails. If no keyFunc is provided, all executions share
+	// the same key. A remembered result is only returned if it's no older than the maxStaleness, where a maxStaleness of 0
+	// means remembered results are always returned. If no remembered result is returned, failures are handled by any
+	// handlers or fallback funcs, and if there are none, are returned as is.
+	//
+	// Since a result is remembered for each key, the keyFunc should return keys from a bounded set, such as config or
+	// pricing keys.
+	WithLastKnownGood(keyFunc func(exec failsafe.Execution[R]) string, maxStaleness time.Duration) FallbackBuilder[R]
+
 	// Build returns a new Fallback using the builder's configuration.
 	Build() Fallback[R]
 }
 
 type fallbackConfig[R any] struct {
 	*policy.BaseFailurePolicy[R]
-	fn                 func(failsafe.Execution[R]) (R, error)
+	fns                []func(failsafe.Execution[R]) (R, error)
+	handlers           []handler[R]
 	onFallbackExecuted func(failsafe.ExecutionDoneEvent[R])
+	detachContext      bool
+	detachedTimeout    time.Duration
+
+	// Last known good
+	lastKnownGood bool
+	keyFunc       func(failsafe.Execution[R]) string
+	maxStaleness  time.Duration
 }
 
 var _ FallbackBuilder[any] = &fallbackConfig[any]{}
 
+// handler is a fallback func that handles failures that its matcher matches.
+type handler[R any] struct {
+	matcher func(R, error) bool
+	fn      func(failsafe.Execution[R]) (R, error)
+}
+
 type fallback[R any] struct {
 	config *fallbackConfig[R]
+	clock  util.Clock
+
+	mtx sync.Mutex
+	// Guarded by mtx
+	lastKnownGood map[string]lastKnownGoodResult[R]
+}
+
+// lastKnownGoodResult is a successful result, along with when it was recorded.
+type lastKnownGoodResult[R any] struct {
+	result     R
+	recordedAt int64
 }
 
 // WithResult returns a Fallback for execution result type R that returns the result when an execution fails.
@@ -61,6 +117,12 @@ func WithFunc[R any](fallbackFunc func(exec failsafe.Execution[R]) (R, error)) F
 	return BuilderWithFunc(fallbackFunc).Build()
 }
 
+// WithFuncs returns a Fallback for execution result type R that uses the fallbackFuncs, in order, to handle a failed
+// execution. See BuilderWithFuncs.
+func WithFuncs[R any](fallbackFuncs ...func(exec failsafe.Execution[R]) (R, error)) Fallback[R] {
+	return BuilderWithFuncs(fallbackFuncs...).Build()
+}
+
 // BuilderWithResult returns a FallbackBuilder for execution result type R which builds Fallbacks that return the result
 // when an execution fails.
 func BuilderWithResult[R any](result R) FallbackBuilder[R] {
@@ -80,12 +142,38 @@ func BuilderWithError[R any](err error) FallbackBuilder[R] {
 // BuilderWithFunc returns a FallbackBuilder for execution result type R which builds Fallbacks that use the fallbackFn to
 // handle failed executions.
 func BuilderWithFunc[R any](fallbackFunc func(exec failsafe.Execution[R]) (R, error)) FallbackBuilder[R] {
+	return BuilderWithFuncs(fallbackFunc)
+}
+
+// BuilderWithFuncs returns a FallbackBuilder for execution result type R which builds Fallbacks that use the
+// fallbackFuncs as tiers to handle failed executions. When an execution fails, the first fallbackFunc is called, and if
+// its result or error is also considered a failure, the next fallbackFunc is called, and so on, until one succeeds. Each
+// fallbackFunc is provided an execution whose LastResult and LastError are from the previous tier.
+//
+// If all of the fallbackFuncs fail, the last fallbackFunc's result is returned along with an error that joins, via
+// errors.Join, the execution's error and each fallbackFunc's error. The joined error unwraps to each of these errors, so
+// errors.Is and errors.As match any of them, and its message includes all of them.
+func BuilderWithFuncs[R any](fallbackFuncs ...func(exec failsafe.Execution[R]) (R, error)) FallbackBuilder[R] {
 	return &fallbackConfig[R]{
 		BaseFailurePolicy: &policy.BaseFailurePolicy[R]{},
-		fn:                fallbackFunc,
+		fns:               fallbackFuncs,
 	}
 }
 
+// BuilderWithLastKnownGood returns a FallbackBuilder for execution result type R which builds Fallbacks that return the
+// most recent successful result for an execution's key when the execution fails. See
+// FallbackBuilder.WithLastKnownGood.
+func BuilderWithLastKnownGood[R any](keyFunc func(exec failsafe.Execution[R]) string, maxStaleness time.Duration) FallbackBuilder[R] {
+	return BuilderWithFuncs[R]().WithLastKnownGood(keyFunc, maxStaleness)
+}
+
+// BuilderWithHandlerFor returns a FallbackBuilder for execution result type R which builds Fallbacks that use the
+// fallbackFunc to handle failures that the matcher matches, and return other failures as is. Additional handlers can be
+// registered via FallbackBuilder.WithHandlerFor.
+func BuilderWithHandlerFor[R any](matcher func(R, error) bool, fallbackFunc func(exec failsafe.Execution[R]) (R, error)) FallbackBuilder[R] {
+	return BuilderWithFuncs[R]().WithHandlerFor(matcher, fallbackFunc)
+}
+
 func (c *fallbackConfig[R]) HandleErrors(errs ...error) FallbackBuilder[R] {
 	c.BaseFailurePolicy.HandleErrors(errs...)
 	return c
@@ -116,10 +204,77 @@ func (c *fallbackConfig[R]) OnFallbackExecuted(listener func(event failsafe.Exec
 	return c
 }
 
+func (c *fallbackConfig[R]) WithHandlerFor(matcher func(R, error) bool, fallbackFunc func(exec failsafe.Execution[R]) (R, error)) FallbackBuilder[R] {
+	c.handlers = append(c.handlers, handler[R]{
+		matcher: matcher,
+		fn:      fallbackFunc,
+	})
+	return c
+}
+
+func (c *fallbackConfig[R]) WithDetachedContext(timeout time.Duration) FallbackBuilder[R] {
+	c.detachContext = true
+	c.detachedTimeout = timeout
+	return c
+}
+
+func (c *fallbackConfig[R]) WithLastKnownGood(keyFunc func(exec failsafe.Execution[R]) string, maxStaleness time.Duration) FallbackBuilder[R] {
+	c.lastKnownGood = true
+	c.keyFunc = keyFunc
+	c.maxStaleness = maxStaleness
+	return c
+}
+
 func (c *fallbackConfig[R]) Build() Fallback[R] {
 	fbCopy := *c
-	return &fallback[R]{
+	fb := &fallback[R]{
 		config: &fbCopy, // TODO copy base fields
+		clock:  util.NewClock(),
+	}
+	if c.lastKnownGood {
+		fb.lastKnownGood = make(map[string]lastKnownGoodResult[R])
+	}
+	return fb
+}
+
+// lastKnownGoodKey returns the key to remember results for the execution with.
+func (fb *fallback[R]) lastKnownGoodKey(exec failsafe.Execution[R]) string {
+	if fb.config.keyFunc != nil {
+		return fb.config.keyFunc(exec)
+	}
+	return ""
+}
+
+// recordLastKnownGood remembers the result as the last known good result for the key.
+func (fb *fallback[R]) recordLastKnownGood(key string, result R) {
+	fb.mtx.Lock()
+	defer fb.mtx.Unlock()
+	fb.lastKnownGood[key] = lastKnownGoodResult[R]{
+		result:     result,
+		recordedAt: fb.clock.CurrentUnixNano(),
+	}
+}
+
+// getLastKnownGood returns the last known good result for the key, and whether one was found that's not too stale.
+func (fb *fallback[R]) getLastKnownGood(key string) (R, bool) {
+	fb.mtx.Lock()
+	defer fb.mtx.Unlock()
+	lkg, ok := fb.lastKnownGood[key]
+	if !ok || (fb.config.maxStaleness > 0 && fb.clock.CurrentUnixNano()-lkg.recordedAt > fb.config.maxStaleness.Nanoseconds()) {
+		return *new(R), false
+	}
+	return lkg.result, true
+}
+
+// DescribeConfig describes the Fallback's configuration for failsafe.Executor.ConfigJSON.
+func (fb *fallback[R]) DescribeConfig() map[string]any {
+	return map[string]any{
+		"fallbackFuncs":   len(fb.config.fns),
+		"handlers":        len(fb.config.handlers),
+		"detachContext":   fb.config.detachContext,
+		"detachedTimeout": fb.config.detachedTimeout.String(),
+		"lastKnownGood":   fb.config.lastKnownGood,
+		"maxStaleness":    fb.config.maxStaleness.String(),
 	}
 }
 
@@ -0,0 +1,29 @@
+package fallback
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+)
+
+// Asserts that last known good results are not returned once they're older than the max staleness.
+func TestGetLastKnownGoodWithMaxStaleness(t *testing.T) {
+	// Given
+	fb := BuilderWithLastKnownGood[string](nil, time.Second).Build().(*fallback[string])
+	clock := &testutil.TestClock{}
+	fb.clock = clock
+	fb.recordLastKnownGood("", "foo")
+
+	// When / Then
+	clock.CurrentTime = int64(time.Second)
+	result, ok := fb.getLastKnownGood("")
+	assert.True(t, ok)
+	assert.Equal(t, "foo", result)
+
+	clock.CurrentTime++
+	_, ok = fb.getLastKnownGood("")
+	assert.False(t, ok)
+}
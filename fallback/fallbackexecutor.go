@@ -1,6 +1,9 @@
 package fallback
 
 import (
+	"context"
+	"errors"
+
 	"github.com/failsafe-go/failsafe-go"
 	"github.com/failsafe-go/failsafe-go/common"
 	"github.com/failsafe-go/failsafe-go/policy"
@@ -20,30 +23,95 @@ func (e *fallbackExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.
 		execInternal := exec.(policy.ExecutionInternal[R])
 		result := innerFn(exec)
 		result = e.PostExecute(execInternal, result)
-		if !result.Success {
-			// Call fallback fn
-			fallbackResult, fallbackError := e.config.fn(execInternal.CopyWithResult(result))
-			if canceled, cancelResult := execInternal.IsCanceledWithResult(); canceled {
-				return cancelResult
+		if e.config.lastKnownGood {
+			key := e.lastKnownGoodKey(exec)
+			if result.Success {
+				e.recordLastKnownGood(key, result.Result)
+			} else if lkgResult, ok := e.getLastKnownGood(key); ok {
+				if canceled, cancelResult := execInternal.IsCanceledWithResult(); canceled && !e.config.detachContext {
+					return cancelResult
+				}
+				if e.config.onFallbackExecuted != nil {
+					e.config.onFallbackExecuted(failsafe.ExecutionDoneEvent[R]{
+						ExecutionStats: execInternal,
+						Result:         lkgResult,
+					})
+				}
+				return &common.PolicyResult[R]{
+					Result:     lkgResult,
+					Done:       true,
+					Success:    true,
+					SuccessAll: true,
+				}
 			}
+		}
+		if !result.Success {
+			fns := e.fallbackFuncsFor(result)
+			errs := []error{result.Error}
+			for _, fn := range fns {
+				// Call fallback fn
+				fallbackResult, fallbackError := e.callFallback(execInternal, result, fn)
+				if canceled, cancelResult := execInternal.IsCanceledWithResult(); canceled && !e.config.detachContext {
+					return cancelResult
+				}
 
-			if e.config.onFallbackExecuted != nil {
-				e.config.onFallbackExecuted(failsafe.ExecutionDoneEvent[R]{
-					ExecutionStats: execInternal,
-					Result:         fallbackResult,
-					Error:          fallbackError,
-				})
+				if logger := execInternal.Logger(); logger != nil {
+					logger.Debug("fallback executed", "policy", "Fallback", "attempt", execInternal.Attempts(), "error", fallbackError)
+				}
+				if e.config.onFallbackExecuted != nil {
+					e.config.onFallbackExecuted(failsafe.ExecutionDoneEvent[R]{
+						ExecutionStats: execInternal,
+						Result:         fallbackResult,
+						Error:          fallbackError,
+					})
+				}
+
+				success := !e.IsFailure(fallbackResult, fallbackError)
+				result = &common.PolicyResult[R]{
+					Result:     fallbackResult,
+					Error:      fallbackError,
+					Done:       true,
+					Success:    success,
+					SuccessAll: success,
+					Fallback:   true,
+				}
+				if success {
+					break
+				}
+				errs = append(errs, fallbackError)
 			}
 
-			success := !e.IsFailure(fallbackResult, fallbackError)
-			result = &common.PolicyResult[R]{
-				Result:     fallbackResult,
-				Error:      fallbackError,
-				Done:       true,
-				Success:    success,
-				SuccessAll: success,
+			// Join the errors from each tier if multiple fallback funcs failed
+			if !result.Success && len(fns) > 1 {
+				result.Error = errors.Join(errs...)
 			}
 		}
 		return result
 	}
 }
+
+// fallbackFuncsFor returns the fallback funcs to handle the failed result with, which is the fn for the first handler
+// that matches the result, if any, else the configured fns.
+func (e *fallbackExecutor[R]) fallbackFuncsFor(result *common.PolicyResult[R]) []func(failsafe.Execution[R]) (R, error) {
+	for _, h := range e.config.handlers {
+		if h.matcher(result.Result, result.Error) {
+			return []func(failsafe.Execution[R]) (R, error){h.fn}
+		}
+	}
+	return e.config.fns
+}
+
+// callFallback calls the fallback fn with the result, using a detached context if configured.
+func (e *fallbackExecutor[R]) callFallback(exec policy.ExecutionInternal[R], result *common.PolicyResult[R], fn func(failsafe.Execution[R]) (R, error)) (R, error) {
+	fallbackExec := exec.CopyWithResult(result)
+	if e.config.detachContext {
+		ctx := context.WithoutCancel(exec.Context())
+		if e.config.detachedTimeout > 0 {
+			var cancelFn context.CancelFunc
+			ctx, cancelFn = context.WithTimeout(ctx, e.config.detachedTimeout)
+			defer cancelFn()
+		}
+		fallbackExec = fallbackExec.(policy.ExecutionInternal[R]).CopyWithContext(ctx)
+	}
+	return fn(fallbackExec)
+}
@@ -0,0 +1,2 @@
+// Package faultinjection provides a FaultInjection policy.
+package faultinjection
@@ -0,0 +1,182 @@
+package faultinjection
+
+import (
+	"errors"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// ErrInjected is the error that is returned for an injected failure, unless a different error is configured via
+// WithError.
+var ErrInjected = errors.New("injected fault")
+
+/*
+FaultInjection is a Policy that injects failures and latency into executions, which is useful for chaos testing that
+other policies react to faults as expected. Like any other policy, a FaultInjection can be composed at any depth. For
+example, consider:
+
+	failsafe.NewExecutor(retryPolicy, faultInjection).Get(fn)
+
+Here, faults are injected into each attempt that the RetryPolicy performs.
+
+When a failure is injected, the result of the policies and func that the FaultInjection is composed around is replaced by
+the configured error, without them being called. When latency is injected, the execution is delayed before they're
+called. When a hang is injected, the execution waits until it's canceled, such as by a Timeout, without them being
+called. Faults are only injected while the FaultInjection is enabled, which it is by default.
+
+This type is concurrency safe.
+*/
+type FaultInjection[R any] interface {
+	failsafe.Policy[R]
+
+	// Enable enables fault injection.
+	Enable()
+
+	// Disable disables fault injection, so that executions are performed normally.
+	Disable()
+
+	// IsEnabled returns whether fault injection is enabled.
+	IsEnabled() bool
+}
+
+/*
+FaultInjectionBuilder builds FaultInjection instances.
+
+This type is not concurrency safe.
+*/
+type FaultInjectionBuilder[R any] interface {
+	// WithFailureRate configures the rate, from 0 to 1, at which failures are injected.
+	WithFailureRate(failureRate float64) FaultInjectionBuilder[R]
+
+	// WithLatency configures latency to inject at the latencyRate, from 0 to 1.
+	WithLatency(latency time.Duration, latencyRate float64) FaultInjectionBuilder[R]
+
+	// WithLatencyRange configures latency to inject at the latencyRate, from 0 to 1, where the latency is uniformly
+	// distributed between the minLatency and maxLatency.
+	WithLatencyRange(minLatency time.Duration, maxLatency time.Duration, latencyRate float64) FaultInjectionBuilder[R]
+
+	// WithHangRate configures the rate, from 0 to 1, at which executions hang until they're canceled, which is useful for
+	// testing that timeouts are handled as expected. Hung executions return the cancellation error, such as
+	// timeout.ErrExceeded or context.DeadlineExceeded. Executions that are never canceled, such as those without a Timeout
+	// or context deadline, hang indefinitely.
+	WithHangRate(hangRate float64) FaultInjectionBuilder[R]
+
+	// WithError configures the error to return for injected failures. The default is ErrInjected.
+	WithError(err error) FaultInjectionBuilder[R]
+
+	// OnFaultInjected registers the listener to be called when a failure or latency is injected.
+	OnFaultInjected(listener func(event failsafe.ExecutionEvent[R])) FaultInjectionBuilder[R]
+
+	// Build returns a new FaultInjection using the builder's configuration.
+	Build() FaultInjection[R]
+}
+
+type faultInjectionConfig[R any] struct {
+	failureRate     float64
+	latency         time.Duration
+	maxLatency      time.Duration
+	latencyRate     float64
+	hangRate        float64
+	err             error
+	onFaultInjected func(failsafe.ExecutionEvent[R])
+}
+
+var _ FaultInjectionBuilder[any] = &faultInjectionConfig[any]{}
+
+type faultInjection[R any] struct {
+	config   *faultInjectionConfig[R]
+	disabled atomic.Bool
+}
+
+// WithFailureRate returns a new FaultInjection for execution result type R that injects ErrInjected failures at the
+// failureRate, from 0 to 1.
+func WithFailureRate[R any](failureRate float64) FaultInjection[R] {
+	return Builder[R]().WithFailureRate(failureRate).Build()
+}
+
+// Builder returns a FaultInjectionBuilder for execution result type R, which by default will build a FaultInjection
+// that does not inject any faults, unless configured otherwise.
+func Builder[R any]() FaultInjectionBuilder[R] {
+	return &faultInjectionConfig[R]{
+		err: ErrInjected,
+	}
+}
+
+func (c *faultInjectionConfig[R]) WithFailureRate(failureRate float64) FaultInjectionBuilder[R] {
+	c.failureRate = failureRate
+	return c
+}
+
+func (c *faultInjectionConfig[R]) WithLatency(latency time.Duration, latencyRate float64) FaultInjectionBuilder[R] {
+	c.latency = latency
+	c.maxLatency = latency
+	c.latencyRate = latencyRate
+	return c
+}
+
+func (c *faultInjectionConfig[R]) WithLatencyRange(minLatency time.Duration, maxLatency time.Duration, latencyRate float64) FaultInjectionBuilder[R] {
+	c.latency = minLatency
+	c.maxLatency = maxLatency
+	c.latencyRate = latencyRate
+	return c
+}
+
+func (c *faultInjectionConfig[R]) WithHangRate(hangRate float64) FaultInjectionBuilder[R] {
+	c.hangRate = hangRate
+	return c
+}
+
+func (c *faultInjectionConfig[R]) WithError(err error) FaultInjectionBuilder[R] {
+	c.err = err
+	return c
+}
+
+func (c *faultInjectionConfig[R]) OnFaultInjected(listener func(event failsafe.ExecutionEvent[R])) FaultInjectionBuilder[R] {
+	c.onFaultInjected = listener
+	return c
+}
+
+func (c *faultInjectionConfig[R]) Build() FaultInjection[R] {
+	fiCopy := *c
+	return &faultInjection[R]{
+		config: &fiCopy,
+	}
+}
+
+func (f *faultInjection[R]) Enable() {
+	f.disabled.Store(false)
+}
+
+func (f *faultInjection[R]) Disable() {
+	f.disabled.Store(true)
+}
+
+func (f *faultInjection[R]) IsEnabled() bool {
+	return !f.disabled.Load()
+}
+
+// shouldInject returns whether a fault should be injected for the rate.
+func (f *faultInjection[R]) shouldInject(rate float64) bool {
+	return rate > 0 && f.IsEnabled() && rand.Float64() < rate
+}
+
+// injectedLatency returns the latency to inject, which is uniformly distributed within the configured latency range.
+func (f *faultInjection[R]) injectedLatency() time.Duration {
+	if f.config.maxLatency <= f.config.latency {
+		return f.config.latency
+	}
+	return f.config.latency + time.Duration(rand.Int63n(int64(f.config.maxLatency-f.config.latency)+1))
+}
+
+func (f *faultInjection[R]) ToExecutor(_ R) any {
+	fie := &faultInjectionExecutor[R]{
+		BaseExecutor:   &policy.BaseExecutor[R]{},
+		faultInjection: f,
+	}
+	fie.Executor = fie
+	return fie
+}
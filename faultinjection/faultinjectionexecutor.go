@@ -0,0 +1,60 @@
+package faultinjection
+
+import (
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/common"
+	"github.com/failsafe-go/failsafe-go/internal"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// faultInjectionExecutor is a policy.Executor that handles failures according to a FaultInjection.
+type faultInjectionExecutor[R any] struct {
+	*policy.BaseExecutor[R]
+	*faultInjection[R]
+}
+
+var _ policy.Executor[any] = &faultInjectionExecutor[any]{}
+
+func (e *faultInjectionExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.PolicyResult[R]) func(failsafe.Execution[R]) *common.PolicyResult[R] {
+	return func(exec failsafe.Execution[R]) *common.PolicyResult[R] {
+		execInternal := exec.(policy.ExecutionInternal[R])
+
+		// Inject latency
+		if e.shouldInject(e.config.latencyRate) {
+			e.onFaultInjected(exec)
+			timer := time.NewTimer(e.injectedLatency())
+			select {
+			case <-timer.C:
+			case <-exec.Canceled():
+				timer.Stop()
+				_, cancelResult := execInternal.IsCanceledWithResult()
+				return cancelResult
+			}
+		}
+
+		// Inject hang
+		if e.shouldInject(e.config.hangRate) {
+			e.onFaultInjected(exec)
+			<-exec.Canceled()
+			_, cancelResult := execInternal.IsCanceledWithResult()
+			return cancelResult
+		}
+
+		// Inject failure
+		if e.shouldInject(e.config.failureRate) {
+			e.onFaultInjected(exec)
+			return internal.FailureResult[R](e.config.err)
+		}
+		return innerFn(exec)
+	}
+}
+
+func (e *faultInjectionExecutor[R]) onFaultInjected(exec failsafe.Execution[R]) {
+	if e.config.onFaultInjected != nil {
+		e.config.onFaultInjected(failsafe.ExecutionEvent[R]{
+			ExecutionAttempt: exec,
+		})
+	}
+}
@@ -0,0 +1,2 @@
+// Package guard provides a Guard policy.
+package guard
@@ -0,0 +1,91 @@
+package guard
+
+import (
+	"errors"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// ErrInvalidResult is returned when an execution result fails validation by a Guard. The error returned by the
+// validator is also wrapped, so both can be matched with errors.Is.
+var ErrInvalidResult = errors.New("invalid result")
+
+/*
+Guard is a Policy that validates execution results, treating any result that violates an invariant, such as being too
+large or malformed, as a failure. A result that fails validation is returned with an error wrapping ErrInvalidResult
+and the validator's error, which allows outer policies such as a RetryPolicy or Fallback to react to it.
+
+A Guard only validates the results of the policies and func that are composed inside of it. For example, consider:
+
+	failsafe.NewExecutor(fallback, retryPolicy, guard).Get(fn)
+
+Here the Guard validates each result returned by fn, so an invalid result will be retried, and if retries are exceeded,
+handled by the fallback. Alternatively, placing the Guard outside the RetryPolicy would validate only the final result
+after retries are complete. Results that already have an error are not validated.
+
+This type is concurrency safe.
+*/
+type Guard[R any] interface {
+	failsafe.Policy[R]
+}
+
+/*
+GuardBuilder builds Guard instances.
+
+This type is not concurrency safe.
+*/
+type GuardBuilder[R any] interface {
+	// OnInvalidResult registers the listener to be called when an execution result fails validation. The provided event's
+	// LastResult and LastError will return the invalid result and the validation error.
+	OnInvalidResult(listener func(event failsafe.ExecutionEvent[R])) GuardBuilder[R]
+
+	// Build returns a new Guard using the builder's configuration.
+	Build() Guard[R]
+}
+
+type guardConfig[R any] struct {
+	validator       func(R) error
+	onInvalidResult func(failsafe.ExecutionEvent[R])
+}
+
+var _ GuardBuilder[any] = &guardConfig[any]{}
+
+type guard[R any] struct {
+	config *guardConfig[R]
+}
+
+// WithResultValidator returns a Guard for execution result type R that uses the validator to validate execution results.
+// If the validator returns an error, the result is treated as a failure.
+func WithResultValidator[R any](validator func(R) error) Guard[R] {
+	return BuilderWithResultValidator[R](validator).Build()
+}
+
+// BuilderWithResultValidator returns a GuardBuilder for execution result type R which builds Guards that use the
+// validator to validate execution results. If the validator returns an error, the result is treated as a failure.
+func BuilderWithResultValidator[R any](validator func(R) error) GuardBuilder[R] {
+	return &guardConfig[R]{
+		validator: validator,
+	}
+}
+
+func (c *guardConfig[R]) OnInvalidResult(listener func(event failsafe.ExecutionEvent[R])) GuardBuilder[R] {
+	c.onInvalidResult = listener
+	return c
+}
+
+func (c *guardConfig[R]) Build() Guard[R] {
+	gCopy := *c
+	return &guard[R]{
+		config: &gCopy,
+	}
+}
+
+func (g *guard[R]) ToExecutor(_ R) any {
+	ge := &guardExecutor[R]{
+		BaseExecutor: &policy.BaseExecutor[R]{},
+		guard:        g,
+	}
+	ge.Executor = ge
+	return ge
+}
@@ -0,0 +1,44 @@
+package guard
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/common"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// guardExecutor is a policy.Executor that handles failures according to a Guard.
+type guardExecutor[R any] struct {
+	*policy.BaseExecutor[R]
+	*guard[R]
+}
+
+var _ policy.Executor[any] = &guardExecutor[any]{}
+
+func (e *guardExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.PolicyResult[R]) func(failsafe.Execution[R]) *common.PolicyResult[R] {
+	return func(exec failsafe.Execution[R]) *common.PolicyResult[R] {
+		execInternal := exec.(policy.ExecutionInternal[R])
+		result := innerFn(exec)
+		if result.Error == nil {
+			if err := e.config.validator(result.Result); err != nil {
+				result = &common.PolicyResult[R]{
+					Result: result.Result,
+					Error:  fmt.Errorf("%w: %w", ErrInvalidResult, err),
+					Done:   true,
+				}
+				if e.config.onInvalidResult != nil {
+					e.config.onInvalidResult(failsafe.ExecutionEvent[R]{
+						ExecutionAttempt: execInternal.CopyWithResult(result),
+					})
+				}
+			}
+		}
+		return e.PostExecute(execInternal, result)
+	}
+}
+
+func (e *guardExecutor[R]) IsFailure(_ R, err error) bool {
+	return err != nil && errors.Is(err, ErrInvalidResult)
+}
@@ -1,6 +1,7 @@
 package hedgepolicy
 
 import (
+	"sync"
 	"time"
 
 	"github.com/failsafe-go/failsafe-go"
@@ -9,17 +10,44 @@ import (
 
 // HedgePolicy is a policy that performes additional executions if the initial execution is slow to complete. This policy
 // differs from RetryPolicy since multiple hedged execution may be in progress at the same time. By default, any
-// outstanding hedges are canceled after the first execution result or error returns. The CancelOn and CancelIf methods
+// outstanding hedges are canceled after the first execution result or error returns, unless the error is classified as
+// failsafe.Retryable by a classifier registered via failsafe.SetErrorClassifier. The CancelOn and CancelIf methods
 // can be used to configure a hedge policy to cancel after different results, errors, or conditions. Once the max hedges
 // have been started, they are left to run until a cancellable result is returned, then the remaining hedges are
 // canceled.
 //
+// Hedges are not performed for executions that are set as not idempotent via Execution.SetIdempotent, regardless of the
+// configured delay, since duplicate attempts may be unsafe. This allows one HedgePolicy to be shared by idempotent and
+// non-idempotent executions.
+//
+// When a HedgePolicy is composed inside a RetryPolicy, the primary attempt and its hedges are handled by the RetryPolicy
+// as a single attempt. The result that the HedgePolicy returns, whether from the primary attempt or a hedge, is the
+// result that the RetryPolicy handles, so a successful hedge completes the execution without any retries. If a retry is
+// performed, it starts a new primary attempt, which may be hedged again. Execution.Attempts includes hedges, while
+// Execution.Retries only includes retries.
+//
 // If the execution is configured with a Context, a child context will be created for the execution and canceled when the
 // HedgePolicy is exceeded.
 //
 // This type is concurrency safe.
 type HedgePolicy[R any] interface {
 	failsafe.Policy[R]
+
+	// Metrics returns metrics for the results of the HedgePolicy's executions so far. This is useful for tuning the hedge
+	// delay, since a high proportion of hedge wins may indicate that the delay is too short.
+	Metrics() HedgeMetrics
+}
+
+// HedgeMetrics contains aggregate metrics for a HedgePolicy's executions, indicating whether the primary attempt or a
+// hedge produced the result that was used.
+type HedgeMetrics struct {
+	// PrimaryWins is the number of executions where the result of the primary attempt was used.
+	PrimaryWins uint
+	// HedgeWins is the number of executions where the result of each hedge was used, indexed by hedge, where index 0 is
+	// the first hedge.
+	HedgeWins []uint
+	// HedgesFired is the total number of hedges that were launched.
+	HedgesFired uint
 }
 
 // HedgePolicyBuilder builds HedgePolicy instances.
@@ -44,6 +72,28 @@ type HedgePolicyBuilder[R any] interface {
 	// by default.
 	WithMaxHedges(maxHedges int) HedgePolicyBuilder[R]
 
+	// WithHedgeInterval sets the interval to wait between launching additional hedges, after the first hedge is launched.
+	// This allows multiple hedges to be launched progressively, up to the max hedges, until a result is returned. For
+	// example, a delay of 100ms with a hedgeInterval of 50ms and 3 max hedges will launch hedges at 100ms, 150ms, and 200ms.
+	// By default, the configured delay is used between all hedges.
+	//
+	// Each hedge is a separate attempt against any policies composed inside the HedgePolicy, such as a RateLimiter, so
+	// permits are only used by hedges that are actually launched.
+	WithHedgeInterval(hedgeInterval time.Duration) HedgePolicyBuilder[R]
+
+	// WithJitterStrategy sets the strategy to randomly vary hedge delays by, including any hedge interval, which avoids
+	// launching hedges for concurrent executions at the same time. See failsafe.JitterStrategy for the formula that each
+	// strategy uses. With failsafe.JitterDecorrelated, each hedge delay is based on the previous jittered delay for the same
+	// execution. No jitter is applied by default.
+	WithJitterStrategy(strategy failsafe.JitterStrategy) HedgePolicyBuilder[R]
+
+	// WithLoserGracePeriod sets the period to wait, after a winning result is returned, before canceling any outstanding
+	// attempts. This gives losing attempts that are nearly done, such as writes that are about to commit, a chance to
+	// complete rather than being aborted. The winning result is returned without waiting for the gracePeriod, but losing
+	// attempts continue to consume resources, such as goroutines and connections, until they complete or are canceled.
+	// By default, outstanding attempts are canceled immediately.
+	WithLoserGracePeriod(gracePeriod time.Duration) HedgePolicyBuilder[R]
+
 	// Build returns a new HedgePolicy using the builder's configuration.
 	Build() HedgePolicy[R]
 }
@@ -51,9 +101,12 @@ type HedgePolicyBuilder[R any] interface {
 type hedgePolicyConfig[R any] struct {
 	*policy.BaseAbortablePolicy[R]
 
-	delayFunc failsafe.DelayFunc[R]
-	maxHedges int
-	onHedge   func(failsafe.ExecutionEvent[R])
+	delayFunc        failsafe.DelayFunc[R]
+	hedgeInterval    time.Duration
+	jitterStrategy   failsafe.JitterStrategy
+	loserGracePeriod time.Duration
+	maxHedges        int
+	onHedge          func(failsafe.ExecutionEvent[R])
 }
 
 var _ HedgePolicyBuilder[any] = &hedgePolicyConfig[any]{}
@@ -106,6 +159,10 @@ func BuilderWithDelayFunc[R any](delayFunc failsafe.DelayFunc[R]) HedgePolicyBui
 
 type hedgePolicy[R any] struct {
 	config *hedgePolicyConfig[R]
+
+	mtx sync.Mutex
+	// Guarded by mtx
+	metrics HedgeMetrics
 }
 
 var _ HedgePolicy[any] = &hedgePolicy[any]{}
@@ -135,16 +192,73 @@ func (c *hedgePolicyConfig[R]) WithMaxHedges(maxHedges int) HedgePolicyBuilder[R
 	return c
 }
 
+func (c *hedgePolicyConfig[R]) WithHedgeInterval(hedgeInterval time.Duration) HedgePolicyBuilder[R] {
+	c.hedgeInterval = hedgeInterval
+	return c
+}
+
+func (c *hedgePolicyConfig[R]) WithJitterStrategy(strategy failsafe.JitterStrategy) HedgePolicyBuilder[R] {
+	c.jitterStrategy = strategy
+	return c
+}
+
+func (c *hedgePolicyConfig[R]) WithLoserGracePeriod(gracePeriod time.Duration) HedgePolicyBuilder[R] {
+	c.loserGracePeriod = gracePeriod
+	return c
+}
+
 func (c *hedgePolicyConfig[R]) Build() HedgePolicy[R] {
 	hCopy := *c
 	if !c.BaseAbortablePolicy.IsConfigured() {
-		// Cancel hedges by default after any result is received
+		// Cancel hedges by default after any result is received, other than a retryable error
 		c.AbortIf(func(r R, err error) bool {
+			if classification, ok := failsafe.ClassifyError(err); ok {
+				return classification != failsafe.Retryable
+			}
 			return true
 		})
 	}
 	return &hedgePolicy[R]{
 		config: &hCopy, // TODO copy base fields
+		metrics: HedgeMetrics{
+			HedgeWins: make([]uint, max(0, c.maxHedges)),
+		},
+	}
+}
+
+func (h *hedgePolicy[R]) Metrics() HedgeMetrics {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	metrics := h.metrics
+	metrics.HedgeWins = append([]uint(nil), h.metrics.HedgeWins...)
+	return metrics
+}
+
+// recordWin records that the result of the attempt was used, where attempt 1 is the primary attempt.
+func (h *hedgePolicy[R]) recordWin(attempt int) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	if attempt == 1 {
+		h.metrics.PrimaryWins++
+	} else {
+		h.metrics.HedgeWins[attempt-2]++
+	}
+}
+
+// recordHedge records that a hedge was launched.
+func (h *hedgePolicy[R]) recordHedge() {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	h.metrics.HedgesFired++
+}
+
+// DescribeConfig describes the HedgePolicy's configuration for failsafe.Executor.ConfigJSON.
+func (h *hedgePolicy[R]) DescribeConfig() map[string]any {
+	return map[string]any{
+		"maxHedges":        h.config.maxHedges,
+		"hedgeInterval":    h.config.hedgeInterval.String(),
+		"jitterStrategy":   h.config.jitterStrategy.String(),
+		"loserGracePeriod": h.config.loserGracePeriod.String(),
 	}
 }
 
@@ -1,6 +1,7 @@
 package hedgepolicy
 
 import (
+	"math/rand"
 	"sync/atomic"
 	"time"
 
@@ -29,25 +30,52 @@ func (e *hedgeExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.Pol
 		done := atomic.Bool{}
 		resultCount := atomic.Int32{}
 		resultChan := make(chan *common.PolicyResult[R], 1) // Only the first result is sent
+		complete := func(result *common.PolicyResult[R], attempt int) {
+			if done.CompareAndSwap(false, true) {
+				if e.config.loserGracePeriod > 0 {
+					// Cancel any outstanding attempts after the grace period without recording a result
+					if canceled, cancelResult := parentExecution.IsCanceledWithResult(); canceled {
+						result = cancelResult
+					} else {
+						e.recordWin(attempt)
+						time.AfterFunc(e.config.loserGracePeriod, func() {
+							parentExecution.Cancel(nil)
+						})
+					}
+				} else if cancelResult := parentExecution.Cancel(nil); cancelResult != nil {
+					// Cancel any outstanding attempts without recording a result
+					result = cancelResult
+				} else {
+					e.recordWin(attempt)
+				}
+				resultChan <- result
+			}
+		}
+
+		// Track the attempts that were started and the last result, in case hedging is stopped for an execution that is not
+		// idempotent, in which case the last result to complete is final
+		stopped := atomic.Bool{}
+		startedCount := atomic.Int32{}
+		lastResult := atomic.Pointer[attemptResult[R]]{}
+		var lastDelay time.Duration
 
 		for attempts := 1; ; attempts++ {
-			go func(hedgeExec policy.ExecutionInternal[R]) {
+			startedCount.Add(1)
+			go func(hedgeExec policy.ExecutionInternal[R], attempt int) {
 				result := innerFn(hedgeExec)
-				isFinalResult := int(resultCount.Add(1)) == e.config.maxHedges+1
+				lastResult.Store(&attemptResult[R]{result: result, attempt: attempt})
+				count := resultCount.Add(1)
+				isFinalResult := int(count) == e.config.maxHedges+1 || (stopped.Load() && count == startedCount.Load())
 				isCancellable := e.config.IsAbortable(result.Result, result.Error)
-
-				if (isFinalResult || isCancellable) && done.CompareAndSwap(false, true) {
-					// Cancel any outstanding attempts without recording a result
-					if cancelResult := parentExecution.Cancel(nil); cancelResult != nil {
-						result = cancelResult
-					}
-					resultChan <- result
+				if isFinalResult || isCancellable {
+					complete(result, attempt)
 				}
-			}(execInternal)
+			}(execInternal, attempts)
 
 			if attempts-1 < e.config.maxHedges {
 				// Wait for hedge delay or result
-				timer := time.NewTimer(e.config.delayFunc(exec))
+				lastDelay = e.getDelay(exec, attempts, lastDelay, rand.Float64)
+				timer := time.NewTimer(lastDelay)
 				select {
 				case <-timer.C:
 				case result := <-resultChan:
@@ -66,8 +94,25 @@ func (e *hedgeExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.Pol
 				return cancelResult
 			}
 
+			// Don't hedge executions that are not idempotent, and wait for the outstanding attempts instead
+			if !parentExecution.IsIdempotent() {
+				stopped.Store(true)
+				if resultCount.Load() == startedCount.Load() {
+					last := lastResult.Load()
+					complete(last.result, last.attempt)
+				}
+				return <-resultChan
+			}
+
 			// Prepare for hedge execution
 			execInternal = parentExecution.CopyForHedge().(policy.ExecutionInternal[R])
+			e.recordHedge()
+			if metrics := execInternal.Metrics(); metrics != nil {
+				metrics.IncrementCounter(failsafe.MetricHedges, nil)
+			}
+			if logger := execInternal.Logger(); logger != nil {
+				logger.Debug("hedge started", "policy", "HedgePolicy", "attempt", execInternal.Attempts())
+			}
 
 			// Call hedge listener
 			if e.config.onHedge != nil {
@@ -76,3 +121,24 @@ func (e *hedgeExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.Pol
 		}
 	}
 }
+
+// attemptResult is the result of an attempt, where attempt 1 is the primary attempt.
+type attemptResult[R any] struct {
+	result  *common.PolicyResult[R]
+	attempt int
+}
+
+// getDelay returns the delay to wait before launching the next hedge, given the number of attempts launched so far and
+// the previous delay, with any jitter applied.
+func (e *hedgeExecutor[R]) getDelay(exec failsafe.Execution[R], attempts int, previousDelay time.Duration, random func() float64) time.Duration {
+	var delay time.Duration
+	if attempts > 1 && e.config.hedgeInterval != 0 {
+		delay = e.config.hedgeInterval
+	} else {
+		delay = e.config.delayFunc(exec)
+	}
+	if e.config.jitterStrategy != failsafe.JitterNone {
+		delay = e.config.jitterStrategy.Apply(delay, previousDelay, random())
+	}
+	return delay
+}
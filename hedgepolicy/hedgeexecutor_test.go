@@ -0,0 +1,60 @@
+package hedgepolicy
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+)
+
+func TestGetDelayWithJitterStrategy(t *testing.T) {
+	delay := 100 * time.Millisecond
+	tests := []struct {
+		strategy     failsafe.JitterStrategy
+		min          time.Duration
+		max          time.Duration
+		expectedMean time.Duration
+	}{
+		{failsafe.JitterNone, delay, delay, delay},
+		{failsafe.JitterFull, 0, delay, delay / 2},
+		{failsafe.JitterEqual, delay / 2, delay, delay * 3 / 4},
+		{failsafe.JitterDecorrelated, delay, 3 * delay, 2 * delay},
+	}
+	for _, tc := range tests {
+		t.Run(tc.strategy.String(), func(t *testing.T) {
+			// Given
+			hp := BuilderWithDelay[any](delay).WithJitterStrategy(tc.strategy).Build()
+			executor := hp.ToExecutor(nil).(*hedgeExecutor[any])
+			random := rand.New(rand.NewSource(1))
+
+			// When
+			var total time.Duration
+			for i := 0; i < 10000; i++ {
+				jittered := executor.getDelay(nil, 1, 0, random.Float64)
+
+				// Then
+				assert.GreaterOrEqual(t, jittered, tc.min)
+				assert.LessOrEqual(t, jittered, tc.max)
+				total += jittered
+			}
+			assert.InDelta(t, float64(tc.expectedMean), float64(total/10000), float64(delay)/20)
+		})
+	}
+
+	t.Run("decorrelated with hedge interval", func(t *testing.T) {
+		// Given
+		hp := BuilderWithDelay[any](delay).
+			WithHedgeInterval(delay / 2).
+			WithJitterStrategy(failsafe.JitterDecorrelated).
+			Build()
+		executor := hp.ToExecutor(nil).(*hedgeExecutor[any])
+
+		// When / Then
+		assert.Equal(t, 3*delay, executor.getDelay(nil, 1, 0, func() float64 { return 1 }))
+		assert.Equal(t, delay/2, executor.getDelay(nil, 2, 3*delay, func() float64 { return 0 }))
+		assert.Equal(t, 9*delay, executor.getDelay(nil, 2, 3*delay, func() float64 { return 1 }))
+	})
+}
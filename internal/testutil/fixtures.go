@@ -153,72 +153,96 @@ func MockDelayedResponse(statusCode int, body string, delay time.Duration) *http
 }
 
 type TestExecution[R any] struct {
-	TheLastResult R
-	TheAttempts   int
-	TheRetries    int
-	TheHedges     int
+	TheLastResult    R
+	TheAttempts      int
+	TheRetries       int
+	TheHedges        int
+	TheLastAttempt   bool
+	TheProgress      int64
+	TheNextRetryTime time.Time
+	TheNonIdempotent bool
 }
 
-func (e TestExecution[R]) Attempts() int {
+func (e *TestExecution[R]) Attempts() int {
 	return e.TheAttempts
 }
 
-func (e TestExecution[R]) Executions() int {
+func (e *TestExecution[R]) Executions() int {
 	panic("unimplemented stub")
 }
 
-func (e TestExecution[R]) StartTime() time.Time {
+func (e *TestExecution[R]) StartTime() time.Time {
 	panic("unimplemented stub")
 }
 
-func (e TestExecution[R]) Retries() int {
+func (e *TestExecution[R]) Retries() int {
 	return e.TheRetries
 }
 
-func (e TestExecution[R]) Hedges() int {
+func (e *TestExecution[R]) Hedges() int {
 	return e.TheHedges
 }
 
-func (e TestExecution[R]) IsFirstAttempt() bool {
+func (e *TestExecution[R]) IsFirstAttempt() bool {
 	panic("unimplemented stub")
 }
 
-func (e TestExecution[R]) IsRetry() bool {
+func (e *TestExecution[R]) IsRetry() bool {
 	panic("unimplemented stub")
 }
 
-func (e TestExecution[R]) ElapsedTime() time.Duration {
+func (e *TestExecution[R]) IsLastAttempt() bool {
+	return e.TheLastAttempt
+}
+
+func (e *TestExecution[R]) ElapsedTime() time.Duration {
 	panic("unimplemented stub")
 }
 
-func (e TestExecution[R]) IsHedge() bool {
+func (e *TestExecution[R]) IsHedge() bool {
 	panic("unimplemented stub")
 }
 
-func (e TestExecution[R]) LastResult() R {
+func (e *TestExecution[R]) LastResult() R {
 	return e.TheLastResult
 }
 
-func (e TestExecution[R]) LastError() error {
+func (e *TestExecution[R]) LastError() error {
 	panic("unimplemented stub")
 }
 
-func (e TestExecution[R]) AttemptStartTime() time.Time {
+func (e *TestExecution[R]) AttemptStartTime() time.Time {
 	panic("unimplemented stub")
 }
 
-func (e TestExecution[R]) ElapsedAttemptTime() time.Duration {
+func (e *TestExecution[R]) ElapsedAttemptTime() time.Duration {
 	panic("unimplemented stub")
 }
 
-func (e TestExecution[R]) Context() context.Context {
+func (e *TestExecution[R]) Context() context.Context {
 	return nil
 }
 
-func (e TestExecution[R]) IsCanceled() bool {
+func (e *TestExecution[R]) IsCanceled() bool {
 	panic("unimplemented stub")
 }
 
-func (e TestExecution[R]) Canceled() <-chan struct{} {
+func (e *TestExecution[R]) Canceled() <-chan struct{} {
 	panic("unimplemented stub")
 }
+
+func (e *TestExecution[R]) SetProgress(progress int64) {
+	e.TheProgress = max(e.TheProgress, progress)
+}
+
+func (e *TestExecution[R]) ResumeFrom() int64 {
+	return e.TheProgress
+}
+
+func (e *TestExecution[R]) SetNextRetryTime(nextRetryTime time.Time) {
+	e.TheNextRetryTime = nextRetryTime
+}
+
+func (e *TestExecution[R]) SetIdempotent(idempotent bool) {
+	e.TheNonIdempotent = !idempotent
+}
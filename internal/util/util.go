@@ -2,6 +2,8 @@ package util
 
 import (
 	"time"
+
+	"github.com/failsafe-go/failsafe-go/clock"
 )
 
 type number interface {
@@ -54,6 +56,19 @@ func NewClock() Clock {
 	return &wallClock{}
 }
 
+type clockAdapter struct {
+	clock clock.Clock
+}
+
+func (c *clockAdapter) CurrentUnixNano() int64 {
+	return c.clock.Now().UnixNano()
+}
+
+// NewClockOf returns a Clock that reads the current time from the clock.
+func NewClockOf(clock clock.Clock) Clock {
+	return &clockAdapter{clock: clock}
+}
+
 type Stopwatch interface {
 	ElapsedTime() time.Duration
 
@@ -77,3 +92,24 @@ func (s *wallClockStopwatch) ElapsedTime() time.Duration {
 func (s *wallClockStopwatch) Reset() {
 	s.startTime = time.Now()
 }
+
+type clockStopwatch struct {
+	clock     clock.Clock
+	startTime time.Time
+}
+
+// NewStopwatchOf returns a Stopwatch that measures time with the clock.
+func NewStopwatchOf(clock clock.Clock) Stopwatch {
+	return &clockStopwatch{
+		clock:     clock,
+		startTime: clock.Now(),
+	}
+}
+
+func (s *clockStopwatch) ElapsedTime() time.Duration {
+	return s.clock.Since(s.startTime)
+}
+
+func (s *clockStopwatch) Reset() {
+	s.startTime = s.clock.Now()
+}
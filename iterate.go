@@ -0,0 +1,80 @@
+//go:build go1.23
+
+package failsafe
+
+import (
+	"iter"
+	"sync/atomic"
+)
+
+// producer is implemented by Executors that can pass each result to an emit func as it's produced.
+type producer[R any] interface {
+	produce(fn func() (R, bool, error), emit func(R) bool) error
+}
+
+// Iterate returns an iterator that repeatedly executes the fn with the executor, yielding each successful result, with
+// failures being handled by the executor's policies for each result. Iteration ends when the fn returns a nil error
+// and true, indicating that it's done, in which case the result from that call is not yielded. If an execution fails
+// after the executor's policies are exceeded, the error is yielded along with the zero value for R, and iteration ends.
+// Iteration also ends if the executor's Context is done, in which case the Context's error is yielded. Iteration can be
+// stopped early by breaking out of the range loop.
+//
+// Executors that are not created by this package are iterated via their GetToChannel method in a separate goroutine,
+// in which case the fn may be called once more than the number of results that are consumed.
+//
+// This function requires Go 1.23 or later.
+func Iterate[R any](e Executor[R], fn func() (R, bool, error)) iter.Seq2[R, error] {
+	return func(yield func(R, error) bool) {
+		p, ok := e.(producer[R])
+		if !ok {
+			iterateToChannel(e, fn, yield)
+			return
+		}
+
+		var stopped bool
+		err := p.produce(fn, func(result R) bool {
+			stopped = !yield(result, nil)
+			return !stopped
+		})
+		if err != nil && !stopped {
+			yield(*(new(R)), err)
+		}
+	}
+}
+
+// iterateToChannel yields the results that the e's GetToChannel method produces in a separate goroutine. If iteration
+// is stopped early, production is stopped and any result that's being produced is discarded before returning.
+func iterateToChannel[R any](e Executor[R], fn func() (R, bool, error), yield func(R, error) bool) {
+	var stopped atomic.Bool
+	out := make(chan R)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- e.GetToChannel(func() (R, bool, error) {
+			if stopped.Load() {
+				return *(new(R)), true, nil
+			}
+			return fn()
+		}, out)
+	}()
+
+	for {
+		select {
+		case result := <-out:
+			if !yield(result, nil) {
+				stopped.Store(true)
+				for {
+					select {
+					case <-out:
+					case <-errChan:
+						return
+					}
+				}
+			}
+		case err := <-errChan:
+			if err != nil {
+				yield(*(new(R)), err)
+			}
+			return
+		}
+	}
+}
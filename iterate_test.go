@@ -0,0 +1,163 @@
+//go:build go1.23
+
+package failsafe_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+	"github.com/failsafe-go/failsafe-go/retrypolicy"
+)
+
+func TestIterate(t *testing.T) {
+	rp := retrypolicy.Builder[int]().WithMaxRetries(2).ReturnLastFailure().Build()
+
+	t.Run("until done", func(t *testing.T) {
+		// Given
+		calls := 0
+		executor := failsafe.NewExecutor[int](rp)
+
+		// When
+		var results []int
+		for result, err := range failsafe.Iterate(executor, func() (int, bool, error) {
+			calls++
+			if calls%2 == 0 {
+				// Fail every other call, which is retried
+				return 0, false, testutil.ErrInvalidState
+			}
+			return calls, calls > 6, nil
+		}) {
+			assert.NoError(t, err)
+			results = append(results, result)
+		}
+
+		// Then
+		assert.Equal(t, []int{1, 3, 5}, results)
+	})
+
+	t.Run("with terminal failure", func(t *testing.T) {
+		// Given
+		calls := 0
+		executor := failsafe.NewExecutor[int](rp)
+
+		// When
+		var results []int
+		var errs []error
+		for result, err := range failsafe.Iterate(executor, func() (int, bool, error) {
+			calls++
+			if calls > 2 {
+				return 0, false, testutil.ErrInvalidState
+			}
+			return calls, false, nil
+		}) {
+			results = append(results, result)
+			errs = append(errs, err)
+		}
+
+		// Then
+		assert.Equal(t, []int{1, 2, 0}, results)
+		assert.Equal(t, []error{nil, nil, testutil.ErrInvalidState}, errs)
+		assert.Equal(t, 5, calls)
+	})
+
+	t.Run("when canceled", func(t *testing.T) {
+		// Given
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		executor := failsafe.NewExecutor[int](rp).WithContext(ctx)
+
+		// When
+		var results []int
+		var lastErr error
+		for result, err := range failsafe.Iterate(executor, func() (int, bool, error) {
+			calls++
+			if calls == 3 {
+				cancel()
+			}
+			return calls, false, nil
+		}) {
+			if err != nil {
+				lastErr = err
+				break
+			}
+			results = append(results, result)
+		}
+
+		// Then
+		assert.Equal(t, []int{1, 2}, results)
+		assert.ErrorIs(t, lastErr, context.Canceled)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("when stopped early", func(t *testing.T) {
+		// Given
+		calls := 0
+		executor := failsafe.NewExecutor[int](rp)
+
+		// When
+		for result := range failsafe.Iterate(executor, func() (int, bool, error) {
+			calls++
+			return calls, false, nil
+		}) {
+			if result == 2 {
+				break
+			}
+		}
+
+		// Then
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("with another Executor implementation", func(t *testing.T) {
+		// Given
+		calls := 0
+		executor := wrappedExecutor[int]{failsafe.NewExecutor[int](rp)}
+
+		// When
+		var results []int
+		for result, err := range failsafe.Iterate[int](executor, func() (int, bool, error) {
+			calls++
+			if calls%2 == 0 {
+				return 0, false, testutil.ErrInvalidState
+			}
+			return calls, calls > 6, nil
+		}) {
+			assert.NoError(t, err)
+			results = append(results, result)
+		}
+
+		// Then
+		assert.Equal(t, []int{1, 3, 5}, results)
+	})
+
+	t.Run("with another Executor implementation when stopped early", func(t *testing.T) {
+		// Given
+		calls := 0
+		executor := wrappedExecutor[int]{failsafe.NewExecutor[int](rp)}
+
+		// When
+		var results []int
+		for result := range failsafe.Iterate[int](executor, func() (int, bool, error) {
+			calls++
+			return calls, false, nil
+		}) {
+			results = append(results, result)
+			if result == 2 {
+				break
+			}
+		}
+
+		// Then the next result may have been produced, but is not yielded
+		assert.Equal(t, []int{1, 2}, results)
+		assert.LessOrEqual(t, calls, 3)
+	})
+}
+
+// wrappedExecutor is an Executor implementation from outside the failsafe package.
+type wrappedExecutor[R any] struct {
+	failsafe.Executor[R]
+}
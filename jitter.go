@@ -0,0 +1,61 @@
+package failsafe
+
+import (
+	"time"
+)
+
+// JitterStrategy is a strategy for randomly varying delays, such as retry or hedge delays, so that executions which
+// are delayed at the same time do not all resume at the same time. See
+// retrypolicy.RetryPolicyBuilder.WithJitterStrategy and hedgepolicy.HedgePolicyBuilder.WithJitterStrategy.
+type JitterStrategy int
+
+func (s JitterStrategy) String() string {
+	switch s {
+	case JitterNone:
+		return "none"
+	case JitterFull:
+		return "full"
+	case JitterEqual:
+		return "equal"
+	case JitterDecorrelated:
+		return "decorrelated"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	// JitterNone indicates that delays are not varied.
+	JitterNone JitterStrategy = iota
+
+	// JitterFull indicates that delays are varied between zero and the delay: random(0, delay). This spreads delays the
+	// most, with a mean of half the delay.
+	JitterFull
+
+	// JitterEqual indicates that delays are varied between half the delay and the delay: delay/2 + random(0, delay/2).
+	// This spreads delays while retaining at least half of each delay, with a mean of three quarters of the delay.
+	JitterEqual
+
+	// JitterDecorrelated indicates that delays are varied between the delay and three times the previous delay:
+	// random(delay, max(delay, previousDelay)*3), where previousDelay is the previous jittered delay for the same
+	// execution, if any. Since each delay builds on the previous jittered delay, delays grow randomly over time, and should
+	// be bounded by a max delay.
+	JitterDecorrelated
+)
+
+// Apply returns the delay with jitter applied according to the strategy, using the random value, from 0 to 1. The
+// previousDelay is the delay that was previously returned for the same execution, if any, which is only used by
+// JitterDecorrelated.
+func (s JitterStrategy) Apply(delay time.Duration, previousDelay time.Duration, random float64) time.Duration {
+	switch s {
+	case JitterFull:
+		return time.Duration(random * float64(delay))
+	case JitterEqual:
+		return delay/2 + time.Duration(random*float64(delay/2))
+	case JitterDecorrelated:
+		upper := 3 * max(delay, previousDelay)
+		return delay + time.Duration(random*float64(upper-delay))
+	default:
+		return delay
+	}
+}
@@ -0,0 +1,61 @@
+package failsafe_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+)
+
+func TestJitterStrategyApply(t *testing.T) {
+	delay := 100 * time.Millisecond
+
+	t.Run("none", func(t *testing.T) {
+		assert.Equal(t, delay, failsafe.JitterNone.Apply(delay, 0, .5))
+	})
+
+	t.Run("bounds", func(t *testing.T) {
+		assert.Equal(t, time.Duration(0), failsafe.JitterFull.Apply(delay, 0, 0))
+		assert.Equal(t, delay, failsafe.JitterFull.Apply(delay, 0, 1))
+		assert.Equal(t, delay/2, failsafe.JitterEqual.Apply(delay, 0, 0))
+		assert.Equal(t, delay, failsafe.JitterEqual.Apply(delay, 0, 1))
+		assert.Equal(t, delay, failsafe.JitterDecorrelated.Apply(delay, 0, 0))
+		assert.Equal(t, 3*delay, failsafe.JitterDecorrelated.Apply(delay, 0, 1))
+		assert.Equal(t, 6*delay, failsafe.JitterDecorrelated.Apply(delay, 2*delay, 1))
+	})
+
+	tests := []struct {
+		strategy     failsafe.JitterStrategy
+		min          time.Duration
+		max          time.Duration
+		expectedMean time.Duration
+	}{
+		{failsafe.JitterFull, 0, delay, delay / 2},
+		{failsafe.JitterEqual, delay / 2, delay, delay * 3 / 4},
+		{failsafe.JitterDecorrelated, delay, 3 * delay, 2 * delay},
+	}
+	for _, tc := range tests {
+		t.Run(tc.strategy.String(), func(t *testing.T) {
+			mean := sampleJitter(t, 10000, tc.min, tc.max, func(random float64) time.Duration {
+				return tc.strategy.Apply(delay, 0, random)
+			})
+			assert.InDelta(t, float64(tc.expectedMean), float64(mean), float64(delay)/20)
+		})
+	}
+}
+
+// sampleJitter samples the jitterFunc, asserting each sample is within the bounds, and returns the mean of the samples.
+func sampleJitter(t *testing.T, samples int, min time.Duration, max time.Duration, jitterFunc func(random float64) time.Duration) time.Duration {
+	random := rand.New(rand.NewSource(1))
+	var total time.Duration
+	for i := 0; i < samples; i++ {
+		delay := jitterFunc(random.Float64())
+		assert.GreaterOrEqual(t, delay, min)
+		assert.LessOrEqual(t, delay, max)
+		total += delay
+	}
+	return total / time.Duration(samples)
+}
@@ -0,0 +1,2 @@
+// Package keyedmutex provides a KeyedMutex policy.
+package keyedmutex
@@ -0,0 +1,134 @@
+package keyedmutex
+
+import (
+	"sync"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+/*
+KeyedMutex is a policy that serializes executions that have the same key, while allowing executions with different keys
+to be performed concurrently. For example, a KeyedMutex with a key func that returns a user ID will allow only one
+execution at a time per user.
+
+Executions wait for the lock for their key until it's available or the execution is canceled. Locks for keys that are no
+longer in use are removed.
+
+This type is concurrency safe.
+*/
+type KeyedMutex[R any] interface {
+	failsafe.Policy[R]
+}
+
+/*
+KeyedMutexBuilder builds KeyedMutex instances.
+
+This type is not concurrency safe.
+*/
+type KeyedMutexBuilder[R any] interface {
+	// WithKeyFunc configures the keyFunc that returns the key to lock for an execution. If no keyFunc is configured, all
+	// executions share the same key.
+	WithKeyFunc(keyFunc func(exec failsafe.Execution[R]) string) KeyedMutexBuilder[R]
+
+	// Build returns a new KeyedMutex using the builder's configuration.
+	Build() KeyedMutex[R]
+}
+
+type keyedMutexConfig[R any] struct {
+	keyFunc func(exec failsafe.Execution[R]) string
+}
+
+var _ KeyedMutexBuilder[any] = &keyedMutexConfig[any]{}
+
+type keyedMutex[R any] struct {
+	config *keyedMutexConfig[R]
+
+	mtx sync.Mutex
+	// Guarded by mtx
+	locks map[string]*keyLock
+}
+
+// keyLock is a lock for a key, along with the number of executions that hold or are waiting for the lock.
+type keyLock struct {
+	sem  chan struct{}
+	refs int
+}
+
+// With returns a new KeyedMutex for execution result type R that locks executions by the key returned by the keyFunc.
+func With[R any](keyFunc func(exec failsafe.Execution[R]) string) KeyedMutex[R] {
+	return Builder[R]().WithKeyFunc(keyFunc).Build()
+}
+
+// Builder returns a KeyedMutexBuilder for execution result type R.
+func Builder[R any]() KeyedMutexBuilder[R] {
+	return &keyedMutexConfig[R]{}
+}
+
+func (c *keyedMutexConfig[R]) WithKeyFunc(keyFunc func(exec failsafe.Execution[R]) string) KeyedMutexBuilder[R] {
+	c.keyFunc = keyFunc
+	return c
+}
+
+func (c *keyedMutexConfig[R]) Build() KeyedMutex[R] {
+	kmCopy := *c
+	return &keyedMutex[R]{
+		config: &kmCopy,
+		locks:  make(map[string]*keyLock),
+	}
+}
+
+// acquire acquires the lock for the key, waiting until it's available or the canceled channel is closed. Returns whether
+// the lock was acquired.
+func (m *keyedMutex[R]) acquire(key string, canceled <-chan struct{}) bool {
+	m.mtx.Lock()
+	lock, ok := m.locks[key]
+	if !ok {
+		lock = &keyLock{sem: make(chan struct{}, 1)}
+		m.locks[key] = lock
+	}
+	lock.refs++
+	m.mtx.Unlock()
+
+	select {
+	case lock.sem <- struct{}{}:
+		return true
+	case <-canceled:
+		// Prefer acquiring the lock if it became available when canceled
+		select {
+		case lock.sem <- struct{}{}:
+			return true
+		default:
+		}
+		m.unref(key, lock)
+		return false
+	}
+}
+
+// release releases the lock for the key.
+func (m *keyedMutex[R]) release(key string) {
+	m.mtx.Lock()
+	lock := m.locks[key]
+	m.mtx.Unlock()
+	<-lock.sem
+	m.unref(key, lock)
+}
+
+// unref decrements the references to the lock, removing it once it's unused.
+func (m *keyedMutex[R]) unref(key string, lock *keyLock) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	lock.refs--
+	if lock.refs == 0 {
+		delete(m.locks, key)
+	}
+}
+
+func (m *keyedMutex[R]) ToExecutor(_ R) any {
+	kme := &keyedMutexExecutor[R]{
+		BaseExecutor: &policy.BaseExecutor[R]{},
+		keyedMutex:   m,
+	}
+	kme.Executor = kme
+	return kme
+}
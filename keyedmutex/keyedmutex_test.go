@@ -0,0 +1,27 @@
+package keyedmutex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Asserts that locks are removed once they're no longer used.
+func TestShouldRemoveUnusedLocks(t *testing.T) {
+	// Given
+	km := Builder[any]().Build().(*keyedMutex[any])
+	canceled := make(chan struct{})
+	close(canceled)
+
+	// When
+	assert.True(t, km.acquire("a", nil))
+	assert.False(t, km.acquire("a", canceled))
+	assert.True(t, km.acquire("b", nil))
+
+	// Then
+	assert.Len(t, km.locks, 2)
+	assert.Equal(t, 1, km.locks["a"].refs)
+	km.release("a")
+	km.release("b")
+	assert.Len(t, km.locks, 0)
+}
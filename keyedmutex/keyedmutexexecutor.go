@@ -0,0 +1,31 @@
+package keyedmutex
+
+import (
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/common"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// keyedMutexExecutor is a policy.Executor that handles failures according to a KeyedMutex.
+type keyedMutexExecutor[R any] struct {
+	*policy.BaseExecutor[R]
+	*keyedMutex[R]
+}
+
+var _ policy.Executor[any] = &keyedMutexExecutor[any]{}
+
+func (e *keyedMutexExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.PolicyResult[R]) func(failsafe.Execution[R]) *common.PolicyResult[R] {
+	return func(exec failsafe.Execution[R]) *common.PolicyResult[R] {
+		execInternal := exec.(policy.ExecutionInternal[R])
+		var key string
+		if e.config.keyFunc != nil {
+			key = e.config.keyFunc(exec)
+		}
+		if !e.acquire(key, exec.Canceled()) {
+			_, cancelResult := execInternal.IsCanceledWithResult()
+			return cancelResult
+		}
+		defer e.release(key)
+		return innerFn(exec)
+	}
+}
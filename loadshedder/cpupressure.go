@@ -0,0 +1,47 @@
+package loadshedder
+
+import (
+	"runtime/metrics"
+	"sync"
+)
+
+const (
+	cpuTotalMetric = "/cpu/classes/total:cpu-seconds"
+	cpuIdleMetric  = "/cpu/classes/idle:cpu-seconds"
+)
+
+// cpuPressure computes the CPU pressure of the Go process from runtime/metrics, based on the change in total and idle CPU
+// time since the previous sample.
+type cpuPressure struct {
+	mtx sync.Mutex
+	// Guarded by mtx
+	samples      []metrics.Sample
+	lastTotal    float64
+	lastIdle     float64
+	lastPressure float64
+}
+
+func newCPUPressure() *cpuPressure {
+	return &cpuPressure{
+		samples: []metrics.Sample{{Name: cpuTotalMetric}, {Name: cpuIdleMetric}},
+	}
+}
+
+// pressure returns the proportion of available CPU time that was not idle since the previous sample. If CPU stats have
+// not been updated since the previous sample, the previous pressure is returned.
+func (c *cpuPressure) pressure() float64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	metrics.Read(c.samples)
+	if c.samples[0].Value.Kind() != metrics.KindFloat64 || c.samples[1].Value.Kind() != metrics.KindFloat64 {
+		return 0
+	}
+	total := c.samples[0].Value.Float64()
+	idle := c.samples[1].Value.Float64()
+	if totalDelta := total - c.lastTotal; totalDelta > 0 {
+		c.lastPressure = 1 - (idle-c.lastIdle)/totalDelta
+		c.lastTotal = total
+		c.lastIdle = idle
+	}
+	return c.lastPressure
+}
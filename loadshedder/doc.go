@@ -0,0 +1,2 @@
+// Package loadshedder provides a LoadShedder policy.
+package loadshedder
@@ -0,0 +1,134 @@
+package loadshedder
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// ErrOverloaded is returned when an execution is rejected by a LoadShedder.
+var ErrOverloaded = errors.New("overloaded")
+
+/*
+LoadShedder is a Policy that rejects executions when the process is under resource pressure, as a way of preventing
+overload independent of the health of any downstream dependencies. Pressure is provided by a func that returns a value
+from 0 to 1, such as the proportion of CPU that is in use.
+
+When the pressure exceeds a threshold, a proportional fraction of executions are randomly rejected with ErrOverloaded.
+The fraction scales linearly from 0, when the pressure is at the threshold, to 1, when the pressure is 1. Rejecting
+executions probabilistically avoids the cliff behavior of rejecting all executions once a threshold is crossed.
+
+This type is concurrency safe.
+*/
+type LoadShedder[R any] interface {
+	failsafe.Policy[R]
+
+	// Pressure returns the current pressure, from 0 to 1.
+	Pressure() float64
+
+	// TryAcquirePermit returns whether an execution should be permitted based on the current pressure.
+	TryAcquirePermit() bool
+}
+
+/*
+LoadShedderBuilder builds LoadShedder instances.
+
+This type is not concurrency safe.
+*/
+type LoadShedderBuilder[R any] interface {
+	// WithThreshold configures the pressure, from 0 to 1, above which executions are rejected. The default is 0, meaning
+	// the fraction of executions that are rejected is equal to the pressure.
+	WithThreshold(threshold float64) LoadShedderBuilder[R]
+
+	// OnOverloaded registers the listener to be called when an execution is rejected because of pressure.
+	OnOverloaded(listener func(event failsafe.ExecutionEvent[R])) LoadShedderBuilder[R]
+
+	// Build returns a new LoadShedder using the builder's configuration.
+	Build() LoadShedder[R]
+}
+
+type loadShedderConfig[R any] struct {
+	pressureFunc func() float64
+	threshold    float64
+	onOverloaded func(failsafe.ExecutionEvent[R])
+}
+
+var _ LoadShedderBuilder[any] = &loadShedderConfig[any]{}
+
+type loadShedder[R any] struct {
+	config *loadShedderConfig[R]
+}
+
+// WithPressureFunc returns a new LoadShedder for execution result type R that rejects executions based on the pressure
+// returned by the pressureFunc, which should be from 0 to 1.
+func WithPressureFunc[R any](pressureFunc func() float64) LoadShedder[R] {
+	return BuilderWithPressureFunc[R](pressureFunc).Build()
+}
+
+// BuilderWithPressureFunc returns a LoadShedderBuilder for execution result type R which builds LoadShedders that reject
+// executions based on the pressure returned by the pressureFunc, which should be from 0 to 1.
+func BuilderWithPressureFunc[R any](pressureFunc func() float64) LoadShedderBuilder[R] {
+	return &loadShedderConfig[R]{
+		pressureFunc: pressureFunc,
+	}
+}
+
+// WithCPUPressure returns a new LoadShedder for execution result type R that rejects executions based on the CPU
+// pressure of the Go process. See BuilderWithCPUPressure.
+func WithCPUPressure[R any]() LoadShedder[R] {
+	return BuilderWithCPUPressure[R]().Build()
+}
+
+// BuilderWithCPUPressure returns a LoadShedderBuilder for execution result type R which builds LoadShedders that reject
+// executions based on the CPU pressure of the Go process. CPU pressure is the proportion of CPU time that's available to
+// the process, as defined by GOMAXPROCS, that was not idle. This is read from runtime/metrics, which only updates CPU
+// stats at the end of each GC cycle, so the pressure reflects CPU usage as of the most recent GC.
+func BuilderWithCPUPressure[R any]() LoadShedderBuilder[R] {
+	return BuilderWithPressureFunc[R](newCPUPressure().pressure)
+}
+
+func (c *loadShedderConfig[R]) WithThreshold(threshold float64) LoadShedderBuilder[R] {
+	c.threshold = threshold
+	return c
+}
+
+func (c *loadShedderConfig[R]) OnOverloaded(listener func(event failsafe.ExecutionEvent[R])) LoadShedderBuilder[R] {
+	c.onOverloaded = listener
+	return c
+}
+
+func (c *loadShedderConfig[R]) Build() LoadShedder[R] {
+	lsCopy := *c
+	return &loadShedder[R]{
+		config: &lsCopy,
+	}
+}
+
+func (l *loadShedder[R]) Pressure() float64 {
+	return min(1, max(0, l.config.pressureFunc()))
+}
+
+func (l *loadShedder[R]) TryAcquirePermit() bool {
+	return !shouldReject(l.Pressure(), l.config.threshold, rand.Float64())
+}
+
+// shouldReject returns whether an execution should be rejected for the pressure and threshold, given a random value from
+// 0 to 1.
+func shouldReject(pressure float64, threshold float64, random float64) bool {
+	if pressure <= threshold {
+		return false
+	}
+	rejectionRate := (pressure - threshold) / (1 - threshold)
+	return random < rejectionRate
+}
+
+func (l *loadShedder[R]) ToExecutor(_ R) any {
+	lse := &loadShedderExecutor[R]{
+		BaseExecutor: &policy.BaseExecutor[R]{},
+		loadShedder:  l,
+	}
+	lse.Executor = lse
+	return lse
+}
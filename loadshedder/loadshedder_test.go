@@ -0,0 +1,25 @@
+package loadshedder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldReject(t *testing.T) {
+	assert.False(t, shouldReject(0, 0, 0))
+	assert.True(t, shouldReject(.5, 0, .4))
+	assert.False(t, shouldReject(.5, 0, .6))
+
+	// With a threshold
+	assert.False(t, shouldReject(.5, .5, 0))
+	assert.True(t, shouldReject(.75, .5, .4))
+	assert.False(t, shouldReject(.75, .5, .6))
+	assert.True(t, shouldReject(1, .5, .99))
+}
+
+func TestCPUPressure(t *testing.T) {
+	pressure := newCPUPressure().pressure()
+	assert.GreaterOrEqual(t, pressure, float64(0))
+	assert.LessOrEqual(t, pressure, float64(1))
+}
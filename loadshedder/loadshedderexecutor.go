@@ -0,0 +1,30 @@
+package loadshedder
+
+import (
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/common"
+	"github.com/failsafe-go/failsafe-go/internal"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// loadShedderExecutor is a policy.Executor that handles failures according to a LoadShedder.
+type loadShedderExecutor[R any] struct {
+	*policy.BaseExecutor[R]
+	*loadShedder[R]
+}
+
+var _ policy.Executor[any] = &loadShedderExecutor[any]{}
+
+func (e *loadShedderExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.PolicyResult[R]) func(failsafe.Execution[R]) *common.PolicyResult[R] {
+	return func(exec failsafe.Execution[R]) *common.PolicyResult[R] {
+		if !e.TryAcquirePermit() {
+			if e.config.onOverloaded != nil {
+				e.config.onOverloaded(failsafe.ExecutionEvent[R]{
+					ExecutionAttempt: exec,
+				})
+			}
+			return internal.FailureResult[R](ErrOverloaded)
+		}
+		return innerFn(exec)
+	}
+}
@@ -0,0 +1,44 @@
+package failsafe
+
+import (
+	"strings"
+)
+
+// ErrorMessageMatcher returns a predicate that matches errors whose message contains any of the substrings. The predicate
+// can be used with policy conditions that accept a predicate, such as HandleIf, AbortIf, or CancelIf. This is useful for
+// handling errors from libraries that don't expose error types or sentinel errors.
+//
+// Matching error messages is fragile, since messages are not usually part of a library's API and may change between
+// versions, or contain details that vary between errors. Prefer matching errors with errors.Is or errors.As when possible.
+func ErrorMessageMatcher[R any](substrings ...string) func(R, error) bool {
+	return errorMessageMatcher[R](substrings, func(s string) string {
+		return s
+	})
+}
+
+// ErrorMessageMatcherIgnoreCase returns a predicate that matches errors whose message contains any of the substrings,
+// ignoring case. See ErrorMessageMatcher.
+func ErrorMessageMatcherIgnoreCase[R any](substrings ...string) func(R, error) bool {
+	return errorMessageMatcher[R](substrings, strings.ToLower)
+}
+
+// errorMessageMatcher returns a predicate that matches errors whose message contains any of the substrings, after each is
+// normalized.
+func errorMessageMatcher[R any](substrings []string, normalize func(string) string) func(R, error) bool {
+	normalized := make([]string, len(substrings))
+	for i, substring := range substrings {
+		normalized[i] = normalize(substring)
+	}
+	return func(_ R, err error) bool {
+		if err == nil {
+			return false
+		}
+		msg := normalize(err.Error())
+		for _, substring := range normalized {
+			if strings.Contains(msg, substring) {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,31 @@
+package failsafe_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+)
+
+func TestErrorMessageMatcher(t *testing.T) {
+	matcher := failsafe.ErrorMessageMatcher[any]("connection reset", "too many requests")
+
+	assert.True(t, matcher(nil, errors.New("read: connection reset by peer")))
+	assert.True(t, matcher(nil, fmt.Errorf("request failed: %w", errors.New("too many requests"))))
+	assert.False(t, matcher(nil, errors.New("Connection Reset by peer")))
+	assert.False(t, matcher(nil, errors.New("invalid argument")))
+	assert.False(t, matcher(nil, nil))
+}
+
+func TestErrorMessageMatcherIgnoreCase(t *testing.T) {
+	matcher := failsafe.ErrorMessageMatcherIgnoreCase[any]("Connection Reset", "timeout")
+
+	assert.True(t, matcher(nil, errors.New("read: connection reset by peer")))
+	assert.True(t, matcher(nil, errors.New("CONNECTION RESET")))
+	assert.True(t, matcher(nil, errors.New("i/o Timeout")))
+	assert.False(t, matcher(nil, errors.New("invalid argument")))
+	assert.False(t, matcher(nil, nil))
+}
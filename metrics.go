@@ -0,0 +1,72 @@
+package failsafe
+
+import "context"
+
+// Metrics records metrics that are published by an Executor and its policies, such as to a metrics system like
+// Prometheus. Metrics are identified by a name, such as MetricExecutions, and labels. For a given name, the same label
+// names are always provided. Implementations must be safe for concurrent use. See Executor.WithMetrics.
+type Metrics interface {
+	// IncrementCounter increments the counter with the name and labels by 1.
+	IncrementCounter(name string, labels map[string]string)
+
+	// SetGauge sets the gauge with the name and labels to the value.
+	SetGauge(name string, value float64, labels map[string]string)
+
+	// ObserveHistogram observes the value for the histogram with the name and labels.
+	ObserveHistogram(name string, value float64, labels map[string]string)
+}
+
+// ContextMetrics is a Metrics that can be bound to the context of an execution, such as to attach exemplars that link
+// metrics to the execution's trace. When the Metrics configured via Executor.WithMetrics implements ContextMetrics, the
+// metrics for each execution, including those published by its policies, are published to the Metrics returned by
+// WithContext for the execution's context. When a Tracer is configured, the context contains the execution's span.
+type ContextMetrics interface {
+	Metrics
+
+	// WithContext returns a Metrics that publishes metrics for an execution with the ctx.
+	WithContext(ctx context.Context) Metrics
+}
+
+// Metric names that are published by an Executor and its policies.
+const (
+	// MetricExecutions is a counter of completed executions, with an "outcome" label of "success" or "failure".
+	MetricExecutions = "failsafe_executions_total"
+
+	// MetricExecutionDuration is a histogram of execution durations in seconds, including any retries and delays.
+	MetricExecutionDuration = "failsafe_execution_duration_seconds"
+
+	// MetricExecutionAttempts is a histogram of the number of attempts made per execution.
+	MetricExecutionAttempts = "failsafe_execution_attempts"
+
+	// MetricRetries is a counter of retries that were scheduled by a RetryPolicy.
+	MetricRetries = "failsafe_retries_total"
+
+	// MetricHedges is a counter of hedges that were started by a HedgePolicy.
+	MetricHedges = "failsafe_hedges_total"
+
+	// MetricCircuitBreakerTransitions is a counter of CircuitBreaker state transitions caused by executions, with a "state"
+	// label for the new state.
+	MetricCircuitBreakerTransitions = "failsafe_circuit_breaker_transitions_total"
+
+	// MetricCircuitBreakerState is a gauge of a CircuitBreaker's state after a transition caused by an execution, where 0
+	// is closed, 1 is open, and 2 is half-open.
+	MetricCircuitBreakerState = "failsafe_circuit_breaker_state"
+
+	// MetricTimeoutsExceeded is a counter of attempts that exceeded a Timeout.
+	MetricTimeoutsExceeded = "failsafe_timeouts_exceeded_total"
+
+	// MetricRejections is a counter of executions that were rejected by a policy, with a "policy" label of
+	// "CircuitBreaker", "RateLimiter", "Bulkhead", or "AdaptiveLimiter".
+	MetricRejections = "failsafe_rejections_total"
+)
+
+// recordExecutionMetrics records metrics for a completed execution.
+func recordExecutionMetrics[R any](metrics Metrics, exec *execution[R], success bool) {
+	outcome := "failure"
+	if success {
+		outcome = "success"
+	}
+	metrics.IncrementCounter(MetricExecutions, map[string]string{"outcome": outcome})
+	metrics.ObserveHistogram(MetricExecutionDuration, exec.ElapsedTime().Seconds(), nil)
+	metrics.ObserveHistogram(MetricExecutionAttempts, float64(exec.Attempts()), nil)
+}
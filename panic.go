@@ -0,0 +1,59 @@
+package failsafe
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+)
+
+// ErrPanic is wrapped by the PanicError that is returned when an execution's func panics and the Executor is configured
+// with WithPanicsAsErrors.
+var ErrPanic = errors.New("execution panicked")
+
+// PanicError is returned when an execution's func panics and the Executor is configured with WithPanicsAsErrors, and
+// provides the panic value and the stack trace of the panic. PanicError wraps ErrPanic, so errors.Is(err, ErrPanic) can
+// be used to check for it. If the panic value is an error, PanicError also wraps it. A PanicError is also provided to an
+// OnListenerError listener when a listener panics while the Executor is configured with WithListenerTimeout.
+type PanicError struct {
+	value any
+	stack []byte
+}
+
+// Value returns the value that the func panicked with.
+func (e *PanicError) Value() any {
+	return e.value
+}
+
+// Stack returns the stack trace of the goroutine that panicked, as formatted by runtime/debug.Stack.
+func (e *PanicError) Stack() []byte {
+	return e.stack
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrPanic, e.value)
+}
+
+func (e *PanicError) Unwrap() []error {
+	if err, ok := e.value.(error); ok {
+		return []error{ErrPanic, err}
+	}
+	return []error{ErrPanic}
+}
+
+// newPanicError returns a PanicError for the value that was recovered from a panic, along with the current stack trace.
+func newPanicError(value any) *PanicError {
+	return &PanicError{
+		value: value,
+		stack: debug.Stack(),
+	}
+}
+
+// callRecovering calls the fn with the exec, converting any panic into a PanicError.
+func callRecovering[R any](fn func(exec Execution[R]) (R, error), exec Execution[R]) (result R, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = newPanicError(r)
+		}
+	}()
+	return fn(exec)
+}
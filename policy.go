@@ -1,6 +1,7 @@
 package failsafe
 
 import (
+	"errors"
 	"time"
 )
 
@@ -44,6 +45,27 @@ type FailurePolicyBuilder[S any, R any] interface {
 // DelayFunc returns a duration to delay for given the ExecutionAttempt.
 type DelayFunc[R any] func(exec ExecutionAttempt[R]) time.Duration
 
+// DelayableError is an error that indicates how long to delay before the next execution attempt, such as an error for
+// a response that included a Retry-After hint. See ErrorDelayFunc.
+type DelayableError interface {
+	error
+
+	// RetryAfter returns the duration to delay before the next execution attempt.
+	RetryAfter() time.Duration
+}
+
+// ErrorDelayFunc returns a DelayFunc that delays for the RetryAfter duration of the last error, if the error is or wraps
+// a DelayableError. Otherwise, the DelayFunc returns -1, so that the policy's configured delay is used.
+func ErrorDelayFunc[R any]() DelayFunc[R] {
+	return func(exec ExecutionAttempt[R]) time.Duration {
+		var delayableErr DelayableError
+		if errors.As(exec.LastError(), &delayableErr) {
+			return max(0, delayableErr.RetryAfter())
+		}
+		return -1
+	}
+}
+
 // DelayablePolicyBuilder builds policies that can be delayed between executions.
 type DelayablePolicyBuilder[S any, R any] interface {
 	// WithDelay configures the time to delay between execution attempts.
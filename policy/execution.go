@@ -1,6 +1,10 @@
 package policy
 
 import (
+	"context"
+	"log/slog"
+	"time"
+
 	"github.com/failsafe-go/failsafe-go"
 	"github.com/failsafe-go/failsafe-go/common"
 )
@@ -30,6 +34,52 @@ type ExecutionInternal[R any] interface {
 	// CopyForCancellable creates a cancellable child copy of the execution based on the current execution's context.
 	CopyForCancellable() failsafe.Execution[R]
 
+	// CopyForCancellableWithTimeout creates a cancellable child copy of the execution based on the current execution's
+	// context, where the child context reports a deadline of now plus the timeout. The child context is not canceled when
+	// the deadline is reached, so callers are responsible for canceling the execution.
+	CopyForCancellableWithTimeout(timeout time.Duration) failsafe.Execution[R]
+
+	// CopyWithContext creates a copy of the execution that uses the ctx in place of the current execution's context, such
+	// as a context that is detached from the current execution's cancellation.
+	CopyWithContext(ctx context.Context) failsafe.Execution[R]
+
+	// CopyWithBudgetDeadline creates a copy of the execution with a budget deadline that execution attempts must complete
+	// by. If the execution already has an earlier budget deadline, it is retained.
+	CopyWithBudgetDeadline(deadline time.Time) failsafe.Execution[R]
+
+	// CopyWithRetriesRemaining creates a copy of the execution for an attempt, which is not the last attempt if
+	// retriesRemaining is true or if the execution already has retries remaining, such as from an outer RetryPolicy.
+	CopyWithRetriesRemaining(retriesRemaining bool) failsafe.Execution[R]
+
+	// ContextDeadline returns the deadline that the execution's context will be canceled at, if any. This excludes deadlines
+	// that the context reports but that are enforced separately, such as by a Timeout.
+	ContextDeadline() (time.Time, bool)
+
+	// BudgetDeadline returns the budget deadline that execution attempts must complete by, if any.
+	BudgetDeadline() (time.Time, bool)
+
+	// NextRetryTime returns the time that the next retry should be performed at, if any was set via SetNextRetryTime.
+	NextRetryTime() (time.Time, bool)
+
+	// IsIdempotent returns whether the execution is idempotent, which is true unless set otherwise via SetIdempotent.
+	IsIdempotent() bool
+
+	// Logger returns the logger that policies should log internal events to, if one was configured via
+	// failsafe.Executor.WithLoggerFunc, else nil.
+	Logger() *slog.Logger
+
+	// Metrics returns the metrics that policies should publish metrics to, if configured via
+	// failsafe.Executor.WithMetrics, else nil.
+	Metrics() failsafe.Metrics
+
+	// IsProbe returns whether the execution is a probe, which stateful policies should not record results or consume
+	// permits for. See failsafe.Executor.Probe.
+	IsProbe() bool
+
+	// IsPolicyDisabled returns whether the policy handling the execution has been disabled since the execution reached it,
+	// in which case the policy should pass results through without handling them. See failsafe.Toggleable.
+	IsPolicyDisabled() bool
+
 	// CopyForHedge creates a copy of the execution marked as a hedge.
 	CopyForHedge() failsafe.Execution[R]
 }
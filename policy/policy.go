@@ -50,14 +50,23 @@ func (p *BaseFailurePolicy[R]) OnFailure(listener func(event failsafe.ExecutionE
 
 func (p *BaseFailurePolicy[R]) IsFailure(result R, err error) bool {
 	if len(p.failureConditions) == 0 {
-		return err != nil
+		return isErrorFailure(err)
 	}
 	if util.AppliesToAny(p.failureConditions, result, err) {
 		return true
 	}
 
 	// Fail by default if an error exists and was not checked by a condition
-	return err != nil && !p.errorsChecked
+	return !p.errorsChecked && isErrorFailure(err)
+}
+
+// isErrorFailure returns whether the err is a failure according to any registered error classifier, else whether the
+// err is not nil.
+func isErrorFailure(err error) bool {
+	if classification, ok := failsafe.ClassifyError(err); ok {
+		return classification != failsafe.Ignore
+	}
+	return err != nil
 }
 
 // BaseDelayablePolicy provides a base for implementing DelayablePolicyBuilder.
@@ -113,6 +122,11 @@ func (c *BaseAbortablePolicy[R]) IsConfigured() bool {
 	return len(c.abortConditions) > 0
 }
 
+// IsAbortable returns whether the err is classified as failsafe.Fatal by any registered error classifier, or whether the
+// result or err match any abort conditions.
 func (c *BaseAbortablePolicy[R]) IsAbortable(result R, err error) bool {
+	if classification, ok := failsafe.ClassifyError(err); ok && classification == failsafe.Fatal {
+		return true
+	}
 	return util.AppliesToAny(c.abortConditions, result, err)
 }
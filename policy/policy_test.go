@@ -55,7 +55,7 @@ func TestShouldComputeDelay(t *testing.T) {
 		},
 	}
 
-	assert.Equal(t, expected, policy.ComputeDelay(testutil.TestExecution[any]{
+	assert.Equal(t, expected, policy.ComputeDelay(&testutil.TestExecution[any]{
 		TheLastResult: true,
 	}))
 	assert.Equal(t, time.Duration(-1), policy.ComputeDelay(nil))
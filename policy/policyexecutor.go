@@ -59,6 +59,9 @@ func (e *BaseExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.Poli
 }
 
 func (e *BaseExecutor[R]) PostExecute(exec ExecutionInternal[R], er *common.PolicyResult[R]) *common.PolicyResult[R] {
+	if exec.IsPolicyDisabled() {
+		return er.WithDone(true, er.SuccessAll)
+	}
 	if e.Executor.IsFailure(er.Result, er.Error) {
 		er = e.Executor.OnFailure(exec, er.WithFailure())
 	} else {
@@ -0,0 +1,62 @@
+package failsafe
+
+/*
+PolicySet is a reusable, ordered set of policies that can be used to create Executors, or composed with additional
+policies to create new PolicySets. Policies are composed around a func in the same order as with NewExecutor, where
+the first policy is the outermost.
+
+A PolicySet holds the policy instances it was created with, and does not copy them. Executors that are created from
+the same PolicySet, or from PolicySets composed from it, share those policy instances:
+
+  - Stateful policies, such as CircuitBreaker, RateLimiter, and Bulkhead, share their state across all of those
+    Executors. For example, failures recorded by one Executor can open a CircuitBreaker for the others.
+  - Other policies, such as RetryPolicy, Timeout, and Fallback, track their state per execution, so sharing them only
+    shares their configuration.
+
+To avoid sharing the state of a stateful policy, build a separate policy instance for each PolicySet.
+
+This type is concurrency safe.
+*/
+type PolicySet[R any] interface {
+	// Policies returns a copy of the PolicySet's policies, from the outermost to the innermost.
+	Policies() []Policy[R]
+
+	// WithOuter returns a new PolicySet that composes the policies outside of the PolicySet's policies.
+	WithOuter(policies ...Policy[R]) PolicySet[R]
+
+	// WithInner returns a new PolicySet that composes the policies inside of the PolicySet's policies, closer to the func.
+	WithInner(policies ...Policy[R]) PolicySet[R]
+
+	// Executor returns a new Executor for the PolicySet's policies.
+	Executor() Executor[R]
+}
+
+// NewPolicySet returns a new PolicySet for the policies, which are composed around a func in the same order as with
+// NewExecutor.
+func NewPolicySet[R any](policies ...Policy[R]) PolicySet[R] {
+	return &policySet[R]{
+		policies: append([]Policy[R](nil), policies...),
+	}
+}
+
+type policySet[R any] struct {
+	policies []Policy[R]
+}
+
+var _ PolicySet[any] = &policySet[any]{}
+
+func (s *policySet[R]) Policies() []Policy[R] {
+	return append([]Policy[R](nil), s.policies...)
+}
+
+func (s *policySet[R]) WithOuter(policies ...Policy[R]) PolicySet[R] {
+	return NewPolicySet[R](append(append([]Policy[R](nil), policies...), s.policies...)...)
+}
+
+func (s *policySet[R]) WithInner(policies ...Policy[R]) PolicySet[R] {
+	return NewPolicySet[R](append(s.Policies(), policies...)...)
+}
+
+func (s *policySet[R]) Executor() Executor[R] {
+	return NewExecutor[R](s.Policies()...)
+}
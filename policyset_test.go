@@ -0,0 +1,59 @@
+package failsafe_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/circuitbreaker"
+	"github.com/failsafe-go/failsafe-go/fallback"
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+	"github.com/failsafe-go/failsafe-go/retrypolicy"
+)
+
+// Asserts that Executors created from the same PolicySet share stateful policies.
+func TestPolicySetSharesPolicies(t *testing.T) {
+	// Given
+	rp := retrypolicy.Builder[string]().WithMaxRetries(1).ReturnLastFailure().Build()
+	cb := circuitbreaker.Builder[string]().WithFailureThreshold(2).Build()
+	set := failsafe.NewPolicySet[string](rp, cb)
+	executor1 := set.Executor()
+	executor2 := set.Executor()
+
+	// When
+	_, err1 := executor1.Get(func() (string, error) {
+		return "", testutil.ErrInvalidState
+	})
+	calls := 0
+	_, err2 := executor2.Get(func() (string, error) {
+		calls++
+		return "success", nil
+	})
+
+	// Then
+	assert.ErrorIs(t, err1, testutil.ErrInvalidState)
+	assert.ErrorIs(t, err2, circuitbreaker.ErrOpen)
+	assert.Equal(t, 0, calls)
+	assert.True(t, cb.IsOpen())
+}
+
+func TestPolicySetComposition(t *testing.T) {
+	// Given
+	rp := retrypolicy.WithDefaults[string]()
+	cb := circuitbreaker.WithDefaults[string]()
+	fb := fallback.WithResult("fallback")
+	set := failsafe.NewPolicySet[string](rp)
+
+	// When
+	composed := set.WithOuter(fb).WithInner(cb)
+
+	// Then
+	assert.Equal(t, []failsafe.Policy[string]{rp}, set.Policies())
+	assert.Equal(t, []failsafe.Policy[string]{fb, rp, cb}, composed.Policies())
+	result, err := composed.Executor().Get(func() (string, error) {
+		return "", testutil.ErrInvalidState
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "fallback", result)
+}
@@ -0,0 +1,141 @@
+package ratelimiter
+
+import (
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+)
+
+// defaultPriorityAging is the default interval after which a waiting execution's priority is increased by 1.
+const defaultPriorityAging = time.Second
+
+// priorityWaiter is a prioritized request for permits that is waiting for its turn to reserve permits from a saturated
+// rate limiter.
+type priorityWaiter struct {
+	priority  int
+	seq       uint64
+	startTime time.Time
+	turn      chan struct{} // Receives when it's the waiter's turn to reserve permits
+}
+
+// effectivePriority returns the waiter's priority, increased by 1 for each agingInterval that it has been waiting.
+func (w *priorityWaiter) effectivePriority(now time.Time, agingInterval time.Duration) int {
+	if agingInterval <= 0 {
+		return w.priority
+	}
+	return w.priority + int(now.Sub(w.startTime)/agingInterval)
+}
+
+// acquirePermitsWithPriority acquires permits for the exec, waiting up to the maxWaitTime. When permits are not
+// immediately available, one request at a time has the turn to reserve permits and wait for them, while other requests
+// wait in order of their effective priority, then in FIFO order. Returns ErrExceeded if the permits cannot be acquired
+// within the maxWaitTime, or errCanceled if the execution is canceled while waiting.
+func (r *rateLimiter[R]) acquirePermitsWithPriority(exec failsafe.Execution[R], permits int, maxWaitTime time.Duration) error {
+	startTime := r.config.clock.Now()
+	r.mtx.Lock()
+	if !r.turnTaken {
+		r.turnTaken = true
+		r.mtx.Unlock()
+
+		// Try to acquire permits without waiting, outside of the lock since a Store may be called
+		if r.stats.acquirePermits(permits, 0) == 0 {
+			r.passTurn()
+			return nil
+		}
+	} else {
+		waiter := &priorityWaiter{
+			priority:  r.config.priorityFunc(exec),
+			seq:       r.prioritySeq,
+			startTime: startTime,
+			turn:      make(chan struct{}, 1),
+		}
+		r.prioritySeq++
+		r.priorityWaiters = append(r.priorityWaiters, waiter)
+		r.mtx.Unlock()
+		if err := r.awaitTurn(waiter, exec.Canceled(), maxWaitTime); err != nil {
+			return err
+		}
+	}
+
+	// Reserve and wait for permits while holding the turn
+	defer r.passTurn()
+	if maxWaitTime != -1 {
+		maxWaitTime = max(0, maxWaitTime-r.config.clock.Since(startTime))
+	}
+	waitTime := r.stats.acquirePermits(permits, maxWaitTime)
+	if waitTime == -1 {
+		return ErrExceeded
+	}
+	timer := r.config.clock.NewTimer(waitTime)
+	select {
+	case <-timer.C():
+		return nil
+	case <-exec.Canceled():
+		timer.Stop()
+		return errCanceled
+	}
+}
+
+// awaitTurn waits until it's the waiter's turn to reserve permits, returning ErrExceeded if the maxWaitTime is exceeded
+// first, or errCanceled if the canceled channel is closed first.
+func (r *rateLimiter[R]) awaitTurn(waiter *priorityWaiter, canceled <-chan struct{}, maxWaitTime time.Duration) error {
+	var timeout <-chan time.Time
+	if maxWaitTime != -1 {
+		timer := r.config.clock.NewTimer(maxWaitTime)
+		defer timer.Stop()
+		timeout = timer.C()
+	}
+	var err error
+	select {
+	case <-waiter.turn:
+		return nil
+	case <-timeout:
+		err = ErrExceeded
+	case <-canceled:
+		err = errCanceled
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	select {
+	case <-waiter.turn:
+		// The turn was passed to the waiter while giving up, so pass it on
+		r.passTurnLocked()
+	default:
+		for i, w := range r.priorityWaiters {
+			if w == waiter {
+				r.priorityWaiters = append(r.priorityWaiters[:i], r.priorityWaiters[i+1:]...)
+				break
+			}
+		}
+	}
+	return err
+}
+
+// passTurn passes the turn to reserve permits to the waiter with the highest effective priority, if any.
+func (r *rateLimiter[R]) passTurn() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.passTurnLocked()
+}
+
+// Requires external locking.
+func (r *rateLimiter[R]) passTurnLocked() {
+	if len(r.priorityWaiters) == 0 {
+		r.turnTaken = false
+		return
+	}
+
+	now := r.config.clock.Now()
+	next := 0
+	nextPriority := r.priorityWaiters[0].effectivePriority(now, r.config.priorityAging)
+	for i := 1; i < len(r.priorityWaiters); i++ {
+		// Waiters are ordered by seq, so the first waiter with the highest priority is chosen
+		if priority := r.priorityWaiters[i].effectivePriority(now, r.config.priorityAging); priority > nextPriority {
+			next, nextPriority = i, priority
+		}
+	}
+	waiter := r.priorityWaiters[next]
+	r.priorityWaiters = append(r.priorityWaiters[:next], r.priorityWaiters[next+1:]...)
+	waiter.turn <- struct{}{}
+}
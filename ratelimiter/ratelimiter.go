@@ -1,11 +1,15 @@
 package ratelimiter
 
 import (
+	"container/list"
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/clock"
 	"github.com/failsafe-go/failsafe-go/internal/util"
 	"github.com/failsafe-go/failsafe-go/policy"
 )
@@ -13,12 +17,34 @@ import (
 // ErrExceeded is returned when an execution exceeds a configured rate limit.
 var ErrExceeded = errors.New("rate limit exceeded")
 
+// ErrPaused is returned when permits are requested from a RateLimiter that is paused and configured to reject requests.
+// ErrPaused wraps ErrExceeded.
+var ErrPaused = fmt.Errorf("rate limiter paused: %w", ErrExceeded)
+
+// errCanceled is used internally when waiting for permits is canceled.
+var errCanceled = errors.New("canceled")
+
+// PauseBehavior describes how a paused RateLimiter handles requests for permits.
+type PauseBehavior int
+
+const (
+	// PauseReject indicates that requests for permits are rejected while a RateLimiter is paused.
+	PauseReject PauseBehavior = iota
+
+	// PauseWait indicates that requests for permits wait while a RateLimiter is paused, until it's resumed, the max wait
+	// time is exceeded, or the request is canceled. Waiting requests are granted permits in FIFO order when the
+	// RateLimiter is resumed.
+	PauseWait
+)
+
 /*
 RateLimiter is a Policy that can control the rate of executions as a way of preventing system overload.
 
-There are two types of rate limiting: smooth and bursty. Smooth rate limiting will evenly spread out execution requests
-over-time, effectively smoothing out uneven execution request rates. Bursty rate limiting allows potential bursts of
-executions to occur, up to a configured max per time period.
+There are three types of rate limiting: smooth, bursty, and sliding window. Smooth rate limiting will evenly spread out
+execution requests over-time, effectively smoothing out uneven execution request rates. Bursty rate limiting allows
+potential bursts of executions to occur, up to a configured max per time period. Sliding window rate limiting also
+allows bursts of executions, but weights executions from the previous period in order to avoid bursts at period
+boundaries.
 
 Rate limiting is based on permits, which can be requested in order to perform rate limited execution. Permits are
 automatically refreshed over time based on the rate limiter's configuration.
@@ -47,6 +73,11 @@ methods return a boolean.
 The ReservePermit methods attempt to reserve permits and return an expected wait time before the permit can be used.
 This helps integrate with scenarios where you need to wait externally.
 
+A RateLimiter can be paused and resumed, which is useful during maintenance. While paused, requests for permits are
+rejected or wait until the RateLimiter is resumed, depending on the configured PauseBehavior. The TryAcquirePermit and
+TryReservePermit methods return immediately without permits while paused, regardless of the PauseBehavior. The
+ReservePermit and ReservePermits methods are not affected by pausing since they can neither wait nor be rejected.
+
 This type is concurrency safe.
 */
 type RateLimiter[R any] interface {
@@ -101,7 +132,8 @@ type RateLimiter[R any] interface {
 	//
 	//  - Returns the expected wait time for the permit if it was successfully reserved.
 	//  - Returns 0 if the permit was successfully reserved and no waiting is needed.
-	//  - Returns -1 if the permit was not reserved because the wait time would be greater than the maxWaitTime.
+	//  - Returns -1 if the permit was not reserved because the wait time would be greater than the maxWaitTime, or because
+	//    the rate limiter is paused.
 	TryReservePermit(maxWaitTime time.Duration) time.Duration
 
 	// TryReservePermits tries to reserve the permits to perform executions against the rate limiter, and returns the time
@@ -109,8 +141,19 @@ type RateLimiter[R any] interface {
 	//
 	//  - Returns the expected wait time for the permit if it was successfully reserved.
 	//  - Returns 0 if the permit was successfully reserved and no waiting is needed.
-	//  - Returns -1 if the permit was not reserved because the wait time would be greater than the maxWaitTime.
+	//  - Returns -1 if the permit was not reserved because the wait time would be greater than the maxWaitTime, or because
+	//    the rate limiter is paused.
 	TryReservePermits(requestedPermits uint, maxWaitTime time.Duration) time.Duration
+
+	// Pause pauses the rate limiter, causing requests for permits to be rejected or to wait until the rate limiter is
+	// resumed, depending on the configured PauseBehavior.
+	Pause()
+
+	// Resume resumes a paused rate limiter, granting permits to any waiting requests in FIFO order.
+	Resume()
+
+	// IsPaused returns whether the rate limiter is paused.
+	IsPaused() bool
 }
 
 /*
@@ -126,9 +169,54 @@ type RateLimiterBuilder[R any] interface {
 	// apply when the RateLimiter is used in a standalone way.
 	WithMaxWaitTime(maxWaitTime time.Duration) RateLimiterBuilder[R]
 
+	// WithPauseBehavior configures how requests for permits are handled while the rate limiter is paused. The default is
+	// PauseReject, where the Acquire methods return ErrPaused and the Try methods return immediately without permits.
+	WithPauseBehavior(pauseBehavior PauseBehavior) RateLimiterBuilder[R]
+
+	// WithPriorityFunc configures a priorityFunc that determines the priority of executions that wait for permits when the
+	// rate limiter is saturated, where higher values have a higher priority. Waiting executions acquire permits in order of
+	// their priority, and in FIFO order for executions with the same priority, so that high priority executions are not
+	// starved behind low priority ones. To avoid starving low priority executions, the priority of a waiting execution is
+	// increased by 1 for each second that it waits, which can be configured via WithPriorityAging.
+	//
+	// This setting only applies when the resulting RateLimiter is used with the failsafe.Run or related APIs, and does not
+	// apply while the RateLimiter is paused.
+	WithPriorityFunc(priorityFunc func(exec failsafe.Execution[R]) int) RateLimiterBuilder[R]
+
+	// WithPriorityAging configures the agingInterval after which the priority of an execution that's waiting for permits
+	// is increased by 1, when a priority func is configured via WithPriorityFunc. The default is 1 second. An agingInterval
+	// of 0 disables aging.
+	WithPriorityAging(agingInterval time.Duration) RateLimiterBuilder[R]
+
+	// WithStore configures a Store that permits are counted in, under the key, allowing the rate limit to be enforced across
+	// processes that share the store, such as the replicas of a service. Processes that share a rate limit should use the
+	// same key and configuration.
+	//
+	// When a store is configured, permits are counted in fixed windows of the period that are aligned to the wall clock, as
+	// with a bursty rate limiter, regardless of how the rate limiter was built. For a smooth rate limiter, each window is an
+	// interval that permits 1 execution. Since each permit request is a call to the store, the rate limiter should only be
+	// used where that latency is acceptable. Permits are requested in up to 10 windows, starting with the current one,
+	// after which they're not acquired, even without a max wait time, in which case the ReservePermit methods return -1.
+	// Each call to the store is bounded by a timeout, which can be configured via WithStoreTimeout. If a call to the store
+	// fails or times out, permits are granted, so that executions are not rejected while the store is unavailable, and the
+	// error is provided to any OnStoreError listener. Reset has no effect on the store.
+	WithStore(store Store, key string) RateLimiterBuilder[R]
+
+	// WithStoreTimeout configures the timeout for each call to a Store configured via WithStore, after which permits are
+	// granted without waiting for the store. The default is 1 second.
+	WithStoreTimeout(timeout time.Duration) RateLimiterBuilder[R]
+
+	// WithClock configures the clock that the RateLimiter measures time and waits for permits with. This is useful for
+	// testing rate limiting without real delays, via a clock.FakeClock. The default is clock.New().
+	WithClock(clock clock.Clock) RateLimiterBuilder[R]
+
 	// OnRateLimitExceeded registers the listener to be called when the rate limit is exceeded.
 	OnRateLimitExceeded(listener func(failsafe.ExecutionEvent[R])) RateLimiterBuilder[R]
 
+	// OnStoreError registers the listener to be called with the error when a call to a Store configured via WithStore
+	// fails or times out, in which case permits are granted.
+	OnStoreError(listener func(err error)) RateLimiterBuilder[R]
+
 	// Build returns a new RateLimiter using the builder's configuration.
 	Build() RateLimiter[R]
 }
@@ -136,14 +224,25 @@ type RateLimiterBuilder[R any] interface {
 type rateLimiterConfig[R any] struct {
 	// Common
 	maxWaitTime         time.Duration
+	pauseBehavior       PauseBehavior
+	priorityFunc        func(failsafe.Execution[R]) int
+	priorityAging       time.Duration
 	onRateLimitExceeded func(failsafe.ExecutionEvent[R])
+	clock               clock.Clock
 
 	// Smooth
 	interval time.Duration
 
-	// Bursty
+	// Bursty and sliding window
 	periodPermits int
 	period        time.Duration
+	slidingWindow bool
+
+	// Store
+	store        Store
+	storeKey     string
+	storeTimeout time.Duration
+	onStoreError func(error)
 }
 
 /*
@@ -180,6 +279,9 @@ func Bursty[R any](maxExecutions uint, period time.Duration) RateLimiterBuilder[
 	return &rateLimiterConfig[R]{
 		periodPermits: int(maxExecutions),
 		period:        period,
+		priorityAging: defaultPriorityAging,
+		storeTimeout:  defaultStoreTimeout,
+		clock:         clock.New(),
 	}
 }
 
@@ -196,7 +298,10 @@ will block and wait until the max wait time is exceeded.
 */
 func SmoothBuilder[R any](maxExecutions uint, period time.Duration) RateLimiterBuilder[R] {
 	return &rateLimiterConfig[R]{
-		interval: period / time.Duration(maxExecutions),
+		interval:      period / time.Duration(maxExecutions),
+		priorityAging: defaultPriorityAging,
+		storeTimeout:  defaultStoreTimeout,
+		clock:         clock.New(),
 	}
 }
 
@@ -212,7 +317,10 @@ and wait until the max wait time is exceeded.
 */
 func SmoothBuilderWithMaxRate[R any](maxRate time.Duration) RateLimiterBuilder[R] {
 	return &rateLimiterConfig[R]{
-		interval: maxRate,
+		interval:      maxRate,
+		priorityAging: defaultPriorityAging,
+		storeTimeout:  defaultStoreTimeout,
+		clock:         clock.New(),
 	}
 }
 
@@ -229,6 +337,47 @@ func BurstyBuilder[R any](maxExecutions uint, period time.Duration) RateLimiterB
 	return &rateLimiterConfig[R]{
 		periodPermits: int(maxExecutions),
 		period:        period,
+		priorityAging: defaultPriorityAging,
+		storeTimeout:  defaultStoreTimeout,
+		clock:         clock.New(),
+	}
+}
+
+/*
+SlidingWindow returns a sliding window RateLimiter for execution result type R and the maxExecutions per period. For
+example, a maxExecutions value of 100 with a period of 1 second would allow up to 100 executions in any 1 second
+window. The returned RateLimiter will have a max wait time of 0.
+
+Executions are performed with no delay until they exceed the max rate, after which they are rejected.
+*/
+func SlidingWindow[R any](maxExecutions uint, period time.Duration) RateLimiter[R] {
+	return SlidingWindowBuilder[R](maxExecutions, period).Build()
+}
+
+/*
+SlidingWindowBuilder returns a sliding window RateLimiterBuilder for execution result type R and the maxExecutions per
+period. For example, a maxExecutions value of 100 with a period of 1 second would allow up to 100 executions in any 1
+second window.
+
+Unlike a bursty rate limiter, which allows up to twice the maxExecutions to be performed around a period boundary, a
+sliding window rate limiter weights the executions from the previous period by how much of that period still overlaps
+the sliding window. For example, 25% of the way through a period, the executions in the window are approximated as 75%
+of the previous period's executions plus the current period's executions. This approximation assumes that executions in
+the previous period were evenly distributed, and only requires tracking two counters.
+
+By default, the returned RateLimiterBuilder will have a max wait time of 0.
+
+Executions are performed with no delay up until the maxExecutions are reached for the current window, after which
+executions are either rejected or will block and wait until the max wait time is exceeded.
+*/
+func SlidingWindowBuilder[R any](maxExecutions uint, period time.Duration) RateLimiterBuilder[R] {
+	return &rateLimiterConfig[R]{
+		periodPermits: int(maxExecutions),
+		period:        period,
+		slidingWindow: true,
+		priorityAging: defaultPriorityAging,
+		storeTimeout:  defaultStoreTimeout,
+		clock:         clock.New(),
 	}
 }
 
@@ -237,18 +386,69 @@ func (c *rateLimiterConfig[R]) WithMaxWaitTime(maxWaitTime time.Duration) RateLi
 	return c
 }
 
+func (c *rateLimiterConfig[R]) WithPauseBehavior(pauseBehavior PauseBehavior) RateLimiterBuilder[R] {
+	c.pauseBehavior = pauseBehavior
+	return c
+}
+
+func (c *rateLimiterConfig[R]) WithPriorityFunc(priorityFunc func(exec failsafe.Execution[R]) int) RateLimiterBuilder[R] {
+	c.priorityFunc = priorityFunc
+	return c
+}
+
+func (c *rateLimiterConfig[R]) WithPriorityAging(agingInterval time.Duration) RateLimiterBuilder[R] {
+	c.priorityAging = agingInterval
+	return c
+}
+
+func (c *rateLimiterConfig[R]) WithStore(store Store, key string) RateLimiterBuilder[R] {
+	c.store = store
+	c.storeKey = key
+	return c
+}
+
+func (c *rateLimiterConfig[R]) WithStoreTimeout(timeout time.Duration) RateLimiterBuilder[R] {
+	c.storeTimeout = timeout
+	return c
+}
+
+func (c *rateLimiterConfig[R]) WithClock(clock clock.Clock) RateLimiterBuilder[R] {
+	c.clock = clock
+	return c
+}
+
 func (c *rateLimiterConfig[R]) OnRateLimitExceeded(listener func(event failsafe.ExecutionEvent[R])) RateLimiterBuilder[R] {
 	c.onRateLimitExceeded = listener
 	return c
 }
 
+func (c *rateLimiterConfig[R]) OnStoreError(listener func(err error)) RateLimiterBuilder[R] {
+	c.onStoreError = listener
+	return c
+}
+
 func (c *rateLimiterConfig[R]) Build() RateLimiter[R] {
+	if c.store != nil {
+		return &rateLimiter[R]{
+			config: c,
+			stats:  newStoreRateLimiterStats(c),
+		}
+	}
 	if c.interval != 0 {
 		return &rateLimiter[R]{
 			config: c,
 			stats: &smoothRateLimiterStats[R]{
 				config:    c, // TODO copy base fields
-				stopwatch: util.NewStopwatch(),
+				stopwatch: util.NewStopwatchOf(c.clock),
+			},
+		}
+	}
+	if c.slidingWindow {
+		return &rateLimiter[R]{
+			config: c,
+			stats: &slidingWindowRateLimiterStats[R]{
+				config:    c,
+				stopwatch: util.NewStopwatchOf(c.clock),
 			},
 		}
 	}
@@ -256,7 +456,7 @@ func (c *rateLimiterConfig[R]) Build() RateLimiter[R] {
 		config: c,
 		stats: &burstyRateLimiterStats[R]{
 			config:           c, // TODO copy base fields
-			stopwatch:        util.NewStopwatch(),
+			stopwatch:        util.NewStopwatchOf(c.clock),
 			availablePermits: c.periodPermits,
 		},
 	}
@@ -265,6 +465,24 @@ func (c *rateLimiterConfig[R]) Build() RateLimiter[R] {
 type rateLimiter[R any] struct {
 	config *rateLimiterConfig[R]
 	stats  rateLimiterStats
+
+	mtx sync.Mutex
+	// Guarded by mtx
+	paused          bool
+	waiters         list.List // Of *pauseWaiter
+	turnTaken       bool      // Whether a prioritized request has the turn to reserve permits
+	priorityWaiters []*priorityWaiter
+	prioritySeq     uint64
+}
+
+// pauseWaiter is a request for permits that is waiting for a paused rate limiter to be resumed.
+type pauseWaiter struct {
+	permits     int
+	maxWaitTime time.Duration
+	startTime   time.Time
+	resumed     bool // Whether the waiter was removed by Resume, which will send its waitTime. Guarded by rateLimiter.mtx
+	// Receives the wait time for reserved permits, else -1 if the maxWaitTime would be exceeded
+	waitTime chan time.Duration
 }
 
 func (r *rateLimiter[R]) AcquirePermit(ctx context.Context) error {
@@ -272,17 +490,27 @@ func (r *rateLimiter[R]) AcquirePermit(ctx context.Context) error {
 }
 
 func (r *rateLimiter[R]) AcquirePermits(ctx context.Context, permits uint) error {
-	waitTime := r.ReservePermits(permits)
+	var canceled <-chan struct{}
+	if ctx != nil {
+		canceled = ctx.Done()
+	}
+	waitTime, err := r.reservePermits(canceled, int(permits), -1)
+	if err != nil {
+		if err == errCanceled {
+			return ctx.Err()
+		}
+		return err
+	}
 	if ctx != nil {
-		timer := time.NewTimer(waitTime)
+		timer := r.config.clock.NewTimer(waitTime)
 		select {
-		case <-timer.C:
+		case <-timer.C():
 		case <-ctx.Done():
 			timer.Stop()
 			return ctx.Err()
 		}
 	} else {
-		time.Sleep(waitTime)
+		<-r.config.clock.NewTimer(waitTime).C()
 	}
 	return nil
 }
@@ -296,24 +524,46 @@ func (r *rateLimiter[R]) AcquirePermitsWithMaxWait(ctx context.Context, requeste
 }
 
 func (r *rateLimiter[R]) acquirePermitsWithMaxWait(ctx context.Context, exec failsafe.Execution[R], requestedPermits uint, maxWaitTime time.Duration) error {
-	waitTime := r.stats.acquirePermits(int(requestedPermits), maxWaitTime)
-	if waitTime == -1 {
-		return ErrExceeded
-	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
-	timer := time.NewTimer(waitTime)
+	canceled := ctx.Done()
+	if exec != nil {
+		canceled = exec.Canceled()
+		if r.config.priorityFunc != nil && !r.IsPaused() {
+			if err := r.acquirePermitsWithPriority(exec, int(requestedPermits), maxWaitTime); err != nil {
+				if err == errCanceled {
+					return exec.LastError()
+				}
+				return err
+			}
+			return nil
+		}
+	}
+	waitTime, err := r.reservePermits(canceled, int(requestedPermits), maxWaitTime)
+	if err != nil {
+		if err == errCanceled {
+			if exec != nil {
+				return exec.LastError()
+			}
+			return ctx.Err()
+		}
+		return err
+	}
+	if waitTime == -1 {
+		return ErrExceeded
+	}
+	timer := r.config.clock.NewTimer(waitTime)
 	if exec == nil {
 		select {
-		case <-timer.C:
+		case <-timer.C():
 		case <-ctx.Done():
 			timer.Stop()
 			return ctx.Err()
 		}
 	} else {
 		select {
-		case <-timer.C:
+		case <-timer.C():
 		case <-exec.Canceled():
 			timer.Stop()
 			return exec.LastError()
@@ -343,9 +593,113 @@ func (r *rateLimiter[R]) TryReservePermit(maxWaitTime time.Duration) time.Durati
 }
 
 func (r *rateLimiter[R]) TryReservePermits(requestedPermits uint, maxWaitTime time.Duration) time.Duration {
+	if r.IsPaused() {
+		return -1
+	}
 	return r.stats.acquirePermits(int(requestedPermits), maxWaitTime)
 }
 
+func (r *rateLimiter[R]) Pause() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.paused = true
+}
+
+func (r *rateLimiter[R]) Resume() {
+	r.mtx.Lock()
+	r.paused = false
+	waiters := make([]*pauseWaiter, 0, r.waiters.Len())
+	for r.waiters.Len() > 0 {
+		waiter := r.waiters.Remove(r.waiters.Front()).(*pauseWaiter)
+		waiter.resumed = true
+		waiters = append(waiters, waiter)
+	}
+	r.mtx.Unlock()
+
+	// Reserve permits for waiters in FIFO order, outside of the lock since a Store may be called
+	for _, waiter := range waiters {
+		maxWaitTime := waiter.maxWaitTime
+		if maxWaitTime != -1 {
+			maxWaitTime = max(0, maxWaitTime-r.config.clock.Since(waiter.startTime))
+		}
+		waiter.waitTime <- r.stats.acquirePermits(waiter.permits, maxWaitTime)
+	}
+}
+
+func (r *rateLimiter[R]) IsPaused() bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.paused
+}
+
+// reservePermits reserves the permits and returns the time that must be waited in order to use them, else -1 if the wait
+// time would exceed the maxWaitTime. If the rate limiter is paused, this returns ErrPaused or waits until the rate limiter
+// is resumed, depending on the PauseBehavior. Returns errCanceled if the canceled channel is closed while waiting. A
+// maxWaitTime of -1 indicates no max wait.
+func (r *rateLimiter[R]) reservePermits(canceled <-chan struct{}, permits int, maxWaitTime time.Duration) (time.Duration, error) {
+	r.mtx.Lock()
+	if !r.paused {
+		r.mtx.Unlock()
+		return r.stats.acquirePermits(permits, maxWaitTime), nil
+	}
+	if r.config.pauseBehavior == PauseReject {
+		r.mtx.Unlock()
+		return -1, ErrPaused
+	}
+	waiter := &pauseWaiter{
+		permits:     permits,
+		maxWaitTime: maxWaitTime,
+		startTime:   r.config.clock.Now(),
+		waitTime:    make(chan time.Duration, 1),
+	}
+	elem := r.waiters.PushBack(waiter)
+	r.mtx.Unlock()
+
+	var timeout <-chan time.Time
+	if maxWaitTime != -1 {
+		timer := r.config.clock.NewTimer(maxWaitTime)
+		defer timer.Stop()
+		timeout = timer.C()
+	}
+	var err error
+	select {
+	case waitTime := <-waiter.waitTime:
+		return waitTime, nil
+	case <-timeout:
+	case <-canceled:
+		err = errCanceled
+	}
+
+	r.mtx.Lock()
+	if waiter.resumed {
+		// Permits are being reserved for the waiter while giving up
+		r.mtx.Unlock()
+		return <-waiter.waitTime, nil
+	}
+	r.waiters.Remove(elem)
+	r.mtx.Unlock()
+	return -1, err
+}
+
+// DescribeConfig describes the RateLimiter's configuration for failsafe.Executor.ConfigJSON.
+func (r *rateLimiter[R]) DescribeConfig() map[string]any {
+	config := map[string]any{
+		"maxWaitTime": r.config.maxWaitTime.String(),
+	}
+	if r.config.interval != 0 {
+		config["type"] = "smooth"
+		config["interval"] = r.config.interval.String()
+	} else {
+		config["type"] = "bursty"
+		if r.config.slidingWindow {
+			config["type"] = "slidingWindow"
+		}
+		config["periodPermits"] = r.config.periodPermits
+		config["period"] = r.config.period.String()
+	}
+	return config
+}
+
 func (r *rateLimiter[R]) ToExecutor(_ R) any {
 	rle := &rateLimiterExecutor[R]{
 		BaseExecutor: &policy.BaseExecutor[R]{},
@@ -1,6 +1,7 @@
 package ratelimiter
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -71,8 +72,121 @@ func TestTryReservePermit(t *testing.T) {
 	assert.Equal(t, time.Duration(-1), limiter.TryReservePermit(100*time.Millisecond))
 }
 
+// Asserts that the TryReservePermit methods reserve no permits while paused, regardless of the PauseBehavior, while the
+// ReservePermit methods are unaffected.
+func TestPauseWithReservations(t *testing.T) {
+	for _, pauseBehavior := range []PauseBehavior{PauseReject, PauseWait} {
+		// Given
+		limiter := SmoothBuilderWithMaxRate[any](100 * time.Millisecond).WithPauseBehavior(pauseBehavior).Build()
+		setTestStopwatch(limiter)
+
+		// When
+		limiter.Pause()
+
+		// Then
+		assert.Equal(t, time.Duration(-1), limiter.TryReservePermit(time.Second))
+		assert.Equal(t, time.Duration(-1), limiter.TryReservePermits(2, time.Second))
+		assert.Equal(t, time.Duration(0), limiter.ReservePermit())
+		assert.Equal(t, 100*time.Millisecond, limiter.ReservePermits(1))
+
+		// When
+		limiter.Resume()
+
+		// Then
+		assert.Equal(t, 200*time.Millisecond, limiter.TryReservePermit(time.Second))
+	}
+}
+
+func TestPauseWithReject(t *testing.T) {
+	// Given
+	limiter := SmoothBuilderWithMaxRate[any](100 * time.Nanosecond).Build()
+	setTestStopwatch(limiter)
+
+	// When
+	limiter.Pause()
+
+	// Then
+	assert.True(t, limiter.IsPaused())
+	assert.False(t, limiter.TryAcquirePermit())
+	assert.ErrorIs(t, limiter.AcquirePermit(nil), ErrPaused)
+	assert.ErrorIs(t, limiter.AcquirePermitWithMaxWait(nil, time.Second), ErrExceeded)
+
+	// When
+	limiter.Resume()
+
+	// Then
+	assert.False(t, limiter.IsPaused())
+	assert.True(t, limiter.TryAcquirePermit())
+}
+
+// Asserts that waiters are granted permits in FIFO order when a paused rate limiter is resumed.
+func TestPauseWithWait(t *testing.T) {
+	// Given
+	limiter := SmoothBuilderWithMaxRate[any](100 * time.Millisecond).WithPauseBehavior(PauseWait).Build()
+	setTestStopwatch(limiter)
+	rl := limiter.(*rateLimiter[any])
+	limiter.Pause()
+	assert.False(t, limiter.TryAcquirePermit())
+
+	// When
+	waitTimes := make([]chan time.Duration, 3)
+	for i := range waitTimes {
+		waitTimes[i] = make(chan time.Duration, 1)
+		go func(waitTime chan time.Duration) {
+			wt, err := rl.reservePermits(nil, 1, -1)
+			assert.NoError(t, err)
+			waitTime <- wt
+		}(waitTimes[i])
+		assert.Eventually(t, func() bool {
+			rl.mtx.Lock()
+			defer rl.mtx.Unlock()
+			return rl.waiters.Len() == i+1
+		}, time.Second, time.Millisecond)
+	}
+	limiter.Resume()
+
+	// Then
+	assert.Equal(t, time.Duration(0), <-waitTimes[0])
+	assert.Equal(t, 100*time.Millisecond, <-waitTimes[1])
+	assert.Equal(t, 200*time.Millisecond, <-waitTimes[2])
+	assert.Equal(t, 0, rl.waiters.Len())
+}
+
+// Asserts that waiting for a paused rate limiter respects cancellation and the max wait time.
+func TestPauseWithWaitCanceled(t *testing.T) {
+	// Given
+	limiter := SmoothBuilderWithMaxRate[any](100 * time.Millisecond).WithPauseBehavior(PauseWait).Build()
+	limiter.Pause()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// When / Then
+	assert.ErrorIs(t, limiter.AcquirePermit(ctx), context.DeadlineExceeded)
+	assert.ErrorIs(t, limiter.AcquirePermitWithMaxWait(nil, 50*time.Millisecond), ErrExceeded)
+	assert.Equal(t, 0, limiter.(*rateLimiter[any]).waiters.Len())
+}
+
 func setTestStopwatch[R any](limiter RateLimiter[R]) *testutil.TestStopwatch {
 	stopwatch := &testutil.TestStopwatch{}
 	limiter.(*rateLimiter[R]).stats.(*smoothRateLimiterStats[R]).stopwatch = stopwatch
 	return stopwatch
 }
+
+func TestPassTurnWithPriorityAging(t *testing.T) {
+	// Given
+	rl := SmoothBuilderWithMaxRate[any](time.Second).WithPriorityAging(time.Second).Build().(*rateLimiter[any])
+	now := time.Now()
+	low := &priorityWaiter{priority: 0, seq: 0, startTime: now.Add(-3 * time.Second), turn: make(chan struct{}, 1)}
+	high := &priorityWaiter{priority: 2, seq: 1, startTime: now, turn: make(chan struct{}, 1)}
+	rl.turnTaken = true
+	rl.priorityWaiters = []*priorityWaiter{low, high}
+
+	// When / Then
+	rl.passTurn()
+	assert.Len(t, low.turn, 1)
+	assert.Len(t, high.turn, 0)
+	rl.passTurn()
+	assert.Len(t, high.turn, 1)
+	rl.passTurn()
+	assert.False(t, rl.turnTaken)
+}
@@ -18,7 +18,13 @@ var _ policy.Executor[any] = &rateLimiterExecutor[any]{}
 func (e *rateLimiterExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.PolicyResult[R]) func(failsafe.Execution[R]) *common.PolicyResult[R] {
 	return func(exec failsafe.Execution[R]) *common.PolicyResult[R] {
 		execInternal := exec.(policy.ExecutionInternal[R])
+		if execInternal.IsProbe() {
+			return innerFn(exec)
+		}
 		if err := e.acquirePermitsWithMaxWait(execInternal.Context(), exec, 1, e.config.maxWaitTime); err != nil {
+			if metrics := execInternal.Metrics(); metrics != nil {
+				metrics.IncrementCounter(failsafe.MetricRejections, map[string]string{"policy": "RateLimiter"})
+			}
 			if e.config.onRateLimitExceeded != nil {
 				e.config.onRateLimitExceeded(failsafe.ExecutionEvent[R]{
 					ExecutionAttempt: execInternal,
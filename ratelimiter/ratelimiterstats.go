@@ -124,6 +124,82 @@ func (s *burstyRateLimiterStats[R]) reset() {
 	s.currentPeriod = 0
 }
 
+// A rate limiter implementation that approximates a sliding window of executions using counters for the current and
+// previous periods. The previous period's count is weighted by the portion of the previous period that still overlaps
+// the sliding window. Permits are eagerly recorded against the current period, including permits that must be waited
+// for, so wait times are an approximation.
+type slidingWindowRateLimiterStats[R any] struct {
+	config    *rateLimiterConfig[R]
+	stopwatch util.Stopwatch
+	mtx       sync.Mutex
+
+	// Guarded by mtx
+	currentPeriod   int
+	currentPermits  int
+	previousPermits int
+}
+
+func (s *slidingWindowRateLimiterStats[R]) acquirePermits(requestedPermits int, maxWaitTime time.Duration) time.Duration {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	currentTime := s.stopwatch.ElapsedTime()
+	newCurrentPeriod := int(currentTime / s.config.period)
+
+	// Update current and previous periods
+	if s.currentPeriod < newCurrentPeriod {
+		if newCurrentPeriod-s.currentPeriod == 1 {
+			s.previousPermits = s.currentPermits
+		} else {
+			s.previousPermits = 0
+		}
+		s.currentPermits = 0
+		s.currentPeriod = newCurrentPeriod
+	}
+
+	period := float64(s.config.period)
+	timeInPeriod := float64(currentTime - time.Duration(s.currentPeriod)*s.config.period)
+	periodRatio := timeInPeriod / period
+	maxPermits := float64(s.config.periodPermits)
+	var waitTime time.Duration
+
+	// Permits that can be used in the current period once the previous period's weight has decayed enough
+	remainingPermits := maxPermits - float64(s.currentPermits+requestedPermits)
+	if float64(s.previousPermits)*(1-periodRatio) > remainingPermits {
+		if remainingPermits >= 0 {
+			// Wait until the previous period's weight decays enough within the current period
+			waitRatio := 1 - remainingPermits/float64(s.previousPermits)
+			waitTime = time.Duration((waitRatio - periodRatio) * period)
+		} else {
+			// Wait until the current period's weight decays enough within the next period
+			timeToNextPeriod := time.Duration((1 - periodRatio) * period)
+			nextRemainingPermits := maxPermits - float64(requestedPermits)
+			if float64(s.currentPermits) <= nextRemainingPermits {
+				waitTime = timeToNextPeriod
+			} else {
+				waitRatio := 1.0
+				if nextRemainingPermits >= 0 {
+					waitRatio = 1 - nextRemainingPermits/float64(s.currentPermits)
+				}
+				waitTime = timeToNextPeriod + time.Duration(waitRatio*period)
+			}
+		}
+		if exceedsMaxWaitTime(waitTime, maxWaitTime) {
+			return -1
+		}
+	}
+
+	s.currentPermits += requestedPermits
+	return waitTime
+}
+
+func (s *slidingWindowRateLimiterStats[R]) reset() {
+	s.stopwatch.Reset()
+	s.currentPeriod = 0
+	s.currentPermits = 0
+	s.previousPermits = 0
+}
+
 // exceedsMaxWaitTime returns whether the waitTime would exceed the maxWaitTime, else false if maxWaitTime is -1.
 func exceedsMaxWaitTime(waitTime time.Duration, maxWaitTime time.Duration) bool {
 	if maxWaitTime != -1 && waitTime > maxWaitTime {
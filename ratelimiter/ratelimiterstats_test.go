@@ -11,6 +11,7 @@ import (
 
 var _ rateLimiterStats = &smoothRateLimiterStats[any]{}
 var _ rateLimiterStats = &burstyRateLimiterStats[any]{}
+var _ rateLimiterStats = &slidingWindowRateLimiterStats[any]{}
 
 // Asserts that wait times and available permits are expected, over time, when calling acquirePermits.
 func TestSmoothAcquirePermits(t *testing.T) {
@@ -123,6 +124,59 @@ func TestBurstyAcquirePermits(t *testing.T) {
 	assert.Equal(t, 2, stats.currentPeriod)
 }
 
+// Asserts that wait times are expected, over time, when calling acquirePermits.
+func TestSlidingWindowAcquirePermits(t *testing.T) {
+	// Given 10 max permits per second
+	stats, stopwatch := newSlidingWindowLimiterStats(10, time.Second)
+
+	stopwatch.CurrentTime = testutil.MillisToNanos(500)
+	assert.Equal(t, 0, acquireNTimes(stats, 1, 10))
+	assert.Equal(t, 10, stats.currentPermits)
+
+	// Previous period weighted at 75%, so 2 permits are available
+	stopwatch.CurrentTime = testutil.MillisToNanos(1250)
+	assert.Equal(t, 0, acquire(stats, 2))
+	assert.Equal(t, 10, stats.previousPermits)
+	assert.Equal(t, 2, stats.currentPermits)
+
+	// Must wait until the previous period is weighted at 60%
+	assert.Equal(t, 150, acquire(stats, 2))
+
+	// Must wait until the current period is weighted at 75% in the next period
+	assert.Equal(t, 1000, acquire(stats, 7))
+	assert.Equal(t, 11, stats.currentPermits)
+
+	// Previous periods are dropped after a full period has elapsed
+	stopwatch.CurrentTime = testutil.MillisToNanos(3100)
+	assert.Equal(t, 0, acquire(stats, 10))
+	assert.Equal(t, 0, stats.previousPermits)
+	assert.Equal(t, 3, stats.currentPeriod)
+}
+
+// Asserts that a sliding window prevents the bursts that a bursty rate limiter allows around a period boundary.
+func TestSlidingWindowPreventsBoundaryBursts(t *testing.T) {
+	test := func(stats rateLimiterStats, stopwatch *testutil.TestStopwatch) (permitted int) {
+		// Acquire at the end of the first period and start of the second period
+		stopwatch.CurrentTime = testutil.MillisToNanos(900)
+		for stats.acquirePermits(1, 0) != -1 {
+			permitted++
+		}
+		stopwatch.CurrentTime = testutil.MillisToNanos(1100)
+		for stats.acquirePermits(1, 0) != -1 {
+			permitted++
+		}
+		return permitted
+	}
+
+	// Given 10 max permits per second
+	burstyStats, burstyStopwatch := newBurstyLimiterStats(10, time.Second)
+	slidingStats, slidingStopwatch := newSlidingWindowLimiterStats(10, time.Second)
+
+	// When / Then
+	assert.Equal(t, 20, test(burstyStats, burstyStopwatch))
+	assert.Equal(t, 11, test(slidingStats, slidingStopwatch))
+}
+
 func TestShouldAcquirePermitsEqually(t *testing.T) {
 	test := func(statsFn func() (rateLimiterStats, *testutil.TestStopwatch)) {
 		// Given
@@ -192,6 +246,13 @@ func newBurstyLimiterStats(maxPermits uint, period time.Duration) (*burstyRateLi
 	return stats, stopwatch
 }
 
+func newSlidingWindowLimiterStats(maxPermits uint, period time.Duration) (*slidingWindowRateLimiterStats[any], *testutil.TestStopwatch) {
+	stats := SlidingWindowBuilder[any](maxPermits, period).Build().(*rateLimiter[any]).stats.(*slidingWindowRateLimiterStats[any])
+	stopwatch := &testutil.TestStopwatch{}
+	stats.stopwatch = stopwatch
+	return stats, stopwatch
+}
+
 func acquire(stats rateLimiterStats, permits int) (waitTime int) {
 	return acquireNTimes(stats, permits, 1)
 }
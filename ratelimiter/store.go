@@ -0,0 +1,101 @@
+package ratelimiter
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/failsafe-go/failsafe-go/internal/util"
+)
+
+/*
+Store stores counts of the permits that have been acquired from a RateLimiter, allowing a rate limit to be enforced
+across processes that share the Store, such as the replicas of a service. See RateLimiterBuilder.WithStore.
+
+Implementations must be concurrency safe.
+*/
+type Store interface {
+	// IncrementBy atomically increments the count for the key by the permits and returns the new count. If the key does
+	// not exist, it's created with a count of the permits, and expires after the ttl. Implementations should return an
+	// error when the ctx is done, which occurs when the store timeout is exceeded.
+	IncrementBy(ctx context.Context, key string, permits int, ttl time.Duration) (int64, error)
+}
+
+// defaultStoreTimeout is the default timeout for each call to a Store.
+const defaultStoreTimeout = time.Second
+
+// maxStoreWindows is the max number of windows that permits are requested from a Store in, including the current window,
+// so that a request for permits makes a bounded number of calls to the Store.
+const maxStoreWindows = 10
+
+// A rate limiter implementation that counts permits in fixed windows of the period, in a Store that may be shared across
+// processes. Windows are aligned to the wall clock so that processes agree on window boundaries. Permits that must be
+// waited for are counted against the future window they're acquired in.
+type storeRateLimiterStats[R any] struct {
+	config        *rateLimiterConfig[R]
+	clock         util.Clock
+	periodPermits int
+	period        time.Duration
+}
+
+func newStoreRateLimiterStats[R any](config *rateLimiterConfig[R]) *storeRateLimiterStats[R] {
+	stats := &storeRateLimiterStats[R]{
+		config:        config,
+		clock:         util.NewClockOf(config.clock),
+		periodPermits: config.periodPermits,
+		period:        config.period,
+	}
+	if config.interval != 0 {
+		// Smooth rate limiters permit 1 execution per interval
+		stats.periodPermits = 1
+		stats.period = config.interval
+	}
+	return stats
+}
+
+func (s *storeRateLimiterStats[R]) acquirePermits(requestedPermits int, maxWaitTime time.Duration) time.Duration {
+	now := s.clock.CurrentUnixNano()
+	period := s.period.Nanoseconds()
+	currentWindow := now / period
+	for window := currentWindow; ; window++ {
+		var waitTime time.Duration
+		if window != currentWindow {
+			waitTime = time.Duration(window*period - now)
+		}
+		if exceedsMaxWaitTime(waitTime, maxWaitTime) || window-currentWindow >= maxStoreWindows {
+			return -1
+		}
+
+		ttl := time.Duration((window+1)*period - now)
+		key := s.config.storeKey + ":" + strconv.FormatInt(window, 10)
+		count, err := s.incrementBy(key, requestedPermits, ttl)
+		if err != nil {
+			// Fail open, so that executions are not rejected while the store is unavailable
+			if s.config.onStoreError != nil {
+				s.config.onStoreError(err)
+			}
+			return 0
+		}
+
+		// Permits are acquired if they fit in the window, or if they're the first permits in the window
+		if count <= int64(s.periodPermits) || count == int64(requestedPermits) {
+			return waitTime
+		}
+	}
+}
+
+// incrementBy increments the key in the store, bounded by the store timeout so that an unresponsive store does not block
+// executions.
+func (s *storeRateLimiterStats[R]) incrementBy(key string, permits int, ttl time.Duration) (int64, error) {
+	ctx := context.Background()
+	if s.config.storeTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.storeTimeout)
+		defer cancel()
+	}
+	return s.config.store.IncrementBy(ctx, key, permits, ttl)
+}
+
+// reset does nothing, since the store's counts may be shared with other processes, and expire on their own.
+func (s *storeRateLimiterStats[R]) reset() {
+}
@@ -0,0 +1,184 @@
+package ratelimiter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+)
+
+type testStore struct {
+	mu     sync.Mutex
+	counts map[string]int64
+	calls  int
+	err    error
+}
+
+func (s *testStore) IncrementBy(_ context.Context, key string, permits int, _ time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	if s.err != nil {
+		return 0, s.err
+	}
+	if s.counts == nil {
+		s.counts = make(map[string]int64)
+	}
+	s.counts[key] += int64(permits)
+	return s.counts[key], nil
+}
+
+func newTestStoreRateLimiter(store Store, clock *testutil.TestClock) RateLimiter[any] {
+	limiter := BurstyBuilder[any](2, time.Second).WithStore(store, "test").Build()
+	limiter.(*rateLimiter[any]).stats.(*storeRateLimiterStats[any]).clock = clock
+	return limiter
+}
+
+func TestStoreSharedAcrossRateLimiters(t *testing.T) {
+	// Given
+	store := &testStore{}
+	clock := &testutil.TestClock{CurrentTime: int64(10 * time.Second)}
+	limiter1 := newTestStoreRateLimiter(store, clock)
+	limiter2 := newTestStoreRateLimiter(store, clock)
+
+	// When / Then
+	assert.True(t, limiter1.TryAcquirePermit())
+	assert.True(t, limiter2.TryAcquirePermit())
+	assert.False(t, limiter1.TryAcquirePermit())
+	assert.False(t, limiter2.TryAcquirePermit())
+
+	// Next window
+	clock.CurrentTime += int64(time.Second)
+	assert.True(t, limiter2.TryAcquirePermit())
+}
+
+func TestStoreReservePermit(t *testing.T) {
+	// Given
+	store := &testStore{}
+	clock := &testutil.TestClock{CurrentTime: int64(10*time.Second + 200*time.Millisecond)}
+	limiter := newTestStoreRateLimiter(store, clock)
+
+	// When / Then
+	assert.Equal(t, time.Duration(0), limiter.ReservePermit())
+	assert.Equal(t, time.Duration(0), limiter.ReservePermit())
+	assert.Equal(t, 800*time.Millisecond, limiter.ReservePermit())
+	assert.Equal(t, -1*time.Nanosecond, limiter.TryReservePermit(500*time.Millisecond))
+}
+
+// Asserts that permits are requested in a bounded number of windows when there is no max wait time.
+func TestStoreReservePermitWindowsAreBounded(t *testing.T) {
+	// Given
+	store := &testStore{}
+	clock := &testutil.TestClock{CurrentTime: int64(10 * time.Second)}
+	limiter := newTestStoreRateLimiter(store, clock)
+	for i := 0; i < 2*maxStoreWindows; i++ {
+		assert.NotEqual(t, -1*time.Nanosecond, limiter.ReservePermit())
+	}
+	store.calls = 0
+
+	// When
+	waitTime := limiter.ReservePermit()
+
+	// Then
+	assert.Equal(t, -1*time.Nanosecond, waitTime)
+	assert.Equal(t, maxStoreWindows, store.calls)
+}
+
+func TestStoreFailsOpen(t *testing.T) {
+	var storeErrs []error
+	store := &testStore{err: errors.New("unavailable")}
+	limiter := BurstyBuilder[any](2, time.Second).
+		WithStore(store, "test").
+		OnStoreError(func(err error) {
+			storeErrs = append(storeErrs, err)
+		}).
+		Build()
+
+	assert.True(t, limiter.TryAcquirePermit())
+	assert.True(t, limiter.TryAcquirePermit())
+	assert.True(t, limiter.TryAcquirePermit())
+	assert.Equal(t, []error{store.err, store.err, store.err}, storeErrs)
+}
+
+// hangingStore is a Store that blocks until its ctx is done.
+type hangingStore struct{}
+
+func (s hangingStore) IncrementBy(ctx context.Context, _ string, _ int, _ time.Duration) (int64, error) {
+	<-ctx.Done()
+	return 0, ctx.Err()
+}
+
+func TestStoreTimeout(t *testing.T) {
+	var storeErr error
+	limiter := BurstyBuilder[any](2, time.Second).
+		WithStore(hangingStore{}, "test").
+		WithStoreTimeout(10 * time.Millisecond).
+		OnStoreError(func(err error) {
+			storeErr = err
+		}).
+		Build()
+
+	start := time.Now()
+	assert.True(t, limiter.TryAcquirePermit())
+	assert.Less(t, time.Since(start), time.Second)
+	assert.ErrorIs(t, storeErr, context.DeadlineExceeded)
+}
+
+// blockingStore is a Store that blocks until it's released.
+type blockingStore struct {
+	entered  chan struct{}
+	released chan struct{}
+}
+
+func (s *blockingStore) IncrementBy(_ context.Context, _ string, permits int, _ time.Duration) (int64, error) {
+	s.entered <- struct{}{}
+	<-s.released
+	return int64(permits), nil
+}
+
+// Asserts that the rate limiter's lock is not held while calling the store, including while resuming waiters.
+func TestStoreCalledWithoutLock(t *testing.T) {
+	// Given
+	store := &blockingStore{entered: make(chan struct{}, 1), released: make(chan struct{})}
+	limiter := BurstyBuilder[any](2, time.Second).
+		WithStore(store, "test").
+		WithPauseBehavior(PauseWait).
+		Build()
+	rl := limiter.(*rateLimiter[any])
+	done := make(chan time.Duration, 2)
+
+	// When
+	go func() {
+		done <- limiter.TryReservePermit(time.Second)
+	}()
+	<-store.entered
+
+	// Then
+	assert.False(t, limiter.IsPaused())
+
+	// When a waiter is resumed
+	limiter.Pause()
+	go func() {
+		waitTime, _ := rl.reservePermits(nil, 1, -1)
+		done <- waitTime
+	}()
+	assert.Eventually(t, func() bool {
+		rl.mtx.Lock()
+		defer rl.mtx.Unlock()
+		return rl.waiters.Len() == 1
+	}, time.Second, time.Millisecond)
+	go limiter.Resume()
+
+	// Then
+	assert.Eventually(t, func() bool {
+		return !limiter.IsPaused()
+	}, time.Second, time.Millisecond)
+	close(store.released)
+	assert.Equal(t, time.Duration(0), <-done)
+	assert.Equal(t, time.Duration(0), <-done)
+}
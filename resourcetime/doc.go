@@ -0,0 +1,2 @@
+// Package resourcetime provides a ResourceTimeLimiter policy.
+package resourcetime
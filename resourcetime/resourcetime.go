@@ -0,0 +1,184 @@
+package resourcetime
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// ErrExceeded is returned when an execution exceeds the budget of a ResourceTimeLimiter.
+var ErrExceeded = errors.New("resource time budget exceeded")
+
+/*
+ResourceTimeLimiter is a Policy that limits executions by the total resource time they consume, such as CPU time quotas,
+rather than by the number of executions. Each execution debits its duration, multiplied by a weight, from a budget that
+continuously refills up to its capacity over a period. For example, a budget of 10 seconds per 1 second period allows
+executions to consume up to 10 seconds of resource time each second, such as 10 concurrent executions that each take 1
+second.
+
+Executions are permitted while the budget has resource time available. Since the duration of an execution isn't known
+until it completes, the budget is debited after each execution completes, and may become negative. As a result, there is
+a lag between long executions and the throttling of subsequent executions: executions that start while a long execution
+is still running are not throttled by it, and the budget may be overdrawn by as much as the resource time of concurrent
+executions. While the budget is depleted, executions wait up to a max wait time for it to be refilled, after which
+ErrExceeded is returned.
+
+This type is concurrency safe.
+*/
+type ResourceTimeLimiter[R any] interface {
+	failsafe.Policy[R]
+
+	// Available returns the resource time that is currently available in the budget. This is negative when the budget has
+	// been overdrawn by executions that consumed more resource time than was available.
+	Available() time.Duration
+
+	// TryAcquirePermit tries to acquire a permit to perform an execution, returning whether the permit was acquired. A
+	// permit is available while the budget has resource time available.
+	TryAcquirePermit() bool
+
+	// Record debits the resource time for an execution of the duration, multiplied by the weight, from the budget. This
+	// can be used with TryAcquirePermit when the ResourceTimeLimiter is used outside of an Executor.
+	Record(duration time.Duration)
+}
+
+/*
+ResourceTimeLimiterBuilder builds ResourceTimeLimiter instances.
+
+This type is not concurrency safe.
+*/
+type ResourceTimeLimiterBuilder[R any] interface {
+	// WithWeight configures the weight that each execution's duration is multiplied by when debiting the budget, such as
+	// the number of cores an execution uses. The default is 1.
+	WithWeight(weight float64) ResourceTimeLimiterBuilder[R]
+
+	// WithMaxWaitTime configures the maxWaitTime to wait for the budget to be refilled when it's depleted. If the budget is
+	// not refilled before the maxWaitTime is exceeded, then ErrExceeded is returned. The default is 0.
+	WithMaxWaitTime(maxWaitTime time.Duration) ResourceTimeLimiterBuilder[R]
+
+	// OnBudgetExceeded registers the listener to be called when an execution is rejected because the budget is depleted.
+	OnBudgetExceeded(listener func(event failsafe.ExecutionEvent[R])) ResourceTimeLimiterBuilder[R]
+
+	// Build returns a new ResourceTimeLimiter using the builder's configuration.
+	Build() ResourceTimeLimiter[R]
+}
+
+type resourceTimeLimiterConfig[R any] struct {
+	budget           time.Duration
+	period           time.Duration
+	weight           float64
+	maxWaitTime      time.Duration
+	onBudgetExceeded func(failsafe.ExecutionEvent[R])
+}
+
+var _ ResourceTimeLimiterBuilder[any] = &resourceTimeLimiterConfig[any]{}
+
+type resourceTimeLimiter[R any] struct {
+	config *resourceTimeLimiterConfig[R]
+	mtx    sync.Mutex
+
+	// Guarded by mtx
+	available      float64 // The resource time available in the budget, in nanos
+	lastRefillTime time.Time
+}
+
+// With returns a new ResourceTimeLimiter for execution result type R with a budget of resource time that refills over
+// the period.
+func With[R any](budget time.Duration, period time.Duration) ResourceTimeLimiter[R] {
+	return Builder[R](budget, period).Build()
+}
+
+// Builder returns a ResourceTimeLimiterBuilder for execution result type R with a budget of resource time that refills
+// over the period, which by default will build a ResourceTimeLimiter with a weight of 1 and no max wait time.
+func Builder[R any](budget time.Duration, period time.Duration) ResourceTimeLimiterBuilder[R] {
+	return &resourceTimeLimiterConfig[R]{
+		budget: budget,
+		period: period,
+		weight: 1,
+	}
+}
+
+func (c *resourceTimeLimiterConfig[R]) WithWeight(weight float64) ResourceTimeLimiterBuilder[R] {
+	c.weight = weight
+	return c
+}
+
+func (c *resourceTimeLimiterConfig[R]) WithMaxWaitTime(maxWaitTime time.Duration) ResourceTimeLimiterBuilder[R] {
+	c.maxWaitTime = maxWaitTime
+	return c
+}
+
+func (c *resourceTimeLimiterConfig[R]) OnBudgetExceeded(listener func(event failsafe.ExecutionEvent[R])) ResourceTimeLimiterBuilder[R] {
+	c.onBudgetExceeded = listener
+	return c
+}
+
+func (c *resourceTimeLimiterConfig[R]) Build() ResourceTimeLimiter[R] {
+	rtlCopy := *c
+	return &resourceTimeLimiter[R]{
+		config:         &rtlCopy,
+		available:      float64(c.budget),
+		lastRefillTime: time.Now(),
+	}
+}
+
+func (r *resourceTimeLimiter[R]) Available() time.Duration {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.refill()
+	return time.Duration(r.available)
+}
+
+func (r *resourceTimeLimiter[R]) TryAcquirePermit() bool {
+	return r.reservePermit(0) != -1
+}
+
+func (r *resourceTimeLimiter[R]) Record(duration time.Duration) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.refill()
+	r.available -= float64(duration) * r.config.weight
+}
+
+// refill adds resource time to the budget for the time elapsed since the last refill, up to the budget's capacity.
+// Requires mtx to be held.
+func (r *resourceTimeLimiter[R]) refill() {
+	now := time.Now()
+	elapsed := now.Sub(r.lastRefillTime)
+	r.lastRefillTime = now
+	r.available = min(float64(r.config.budget), r.available+float64(elapsed)*r.refillRate())
+}
+
+// refillRate returns the resource time, in nanos, that is refilled per nano.
+func (r *resourceTimeLimiter[R]) refillRate() float64 {
+	return float64(r.config.budget) / float64(r.config.period)
+}
+
+// reservePermit returns the time that must be waited for the budget to have resource time available, else returns -1
+// if the wait time would exceed the maxWaitTime.
+func (r *resourceTimeLimiter[R]) reservePermit(maxWaitTime time.Duration) time.Duration {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.refill()
+	if r.available > 0 {
+		return 0
+	}
+
+	// Wait until the budget is refilled past 0
+	waitTime := time.Duration(-r.available/r.refillRate()) + 1
+	if waitTime > maxWaitTime {
+		return -1
+	}
+	return waitTime
+}
+
+func (r *resourceTimeLimiter[R]) ToExecutor(_ R) any {
+	rte := &resourceTimeLimiterExecutor[R]{
+		BaseExecutor:        &policy.BaseExecutor[R]{},
+		resourceTimeLimiter: r,
+	}
+	rte.Executor = rte
+	return rte
+}
@@ -0,0 +1,54 @@
+package resourcetime
+
+import (
+	"time"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/common"
+	"github.com/failsafe-go/failsafe-go/internal"
+	"github.com/failsafe-go/failsafe-go/policy"
+)
+
+// resourceTimeLimiterExecutor is a policy.Executor that handles failures according to a ResourceTimeLimiter.
+type resourceTimeLimiterExecutor[R any] struct {
+	*policy.BaseExecutor[R]
+	*resourceTimeLimiter[R]
+}
+
+var _ policy.Executor[any] = &resourceTimeLimiterExecutor[any]{}
+
+func (e *resourceTimeLimiterExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.PolicyResult[R]) func(failsafe.Execution[R]) *common.PolicyResult[R] {
+	return func(exec failsafe.Execution[R]) *common.PolicyResult[R] {
+		execInternal := exec.(policy.ExecutionInternal[R])
+		if execInternal.IsProbe() {
+			return innerFn(exec)
+		}
+
+		// Wait for the budget to have resource time available
+		waitTime := e.reservePermit(e.config.maxWaitTime)
+		if waitTime == -1 {
+			if e.config.onBudgetExceeded != nil {
+				e.config.onBudgetExceeded(failsafe.ExecutionEvent[R]{
+					ExecutionAttempt: execInternal,
+				})
+			}
+			return internal.FailureResult[R](ErrExceeded)
+		}
+		if waitTime > 0 {
+			timer := time.NewTimer(waitTime)
+			select {
+			case <-timer.C:
+			case <-exec.Canceled():
+				timer.Stop()
+				_, cancelResult := execInternal.IsCanceledWithResult()
+				return cancelResult
+			}
+		}
+
+		// Debit the budget after the execution completes
+		startTime := time.Now()
+		result := innerFn(exec)
+		e.Record(time.Since(startTime))
+		return result
+	}
+}
@@ -0,0 +1,134 @@
+package retrypolicy
+
+import (
+	"sync"
+	"time"
+
+	"github.com/failsafe-go/failsafe-go/internal/util"
+)
+
+// The number of buckets that a RetryBudget's ttl is divided into.
+const budgetBuckets = 10
+
+/*
+RetryBudget limits retries to a ratio of the executions that are performed, across any RetryPolicies that share it. This
+caps the retry amplification that a fleet of executors can cause during an outage, since once the budget is exhausted,
+failures are no longer retried until more executions are performed or earlier retries expire from the budget. See
+RetryPolicyBuilder.WithBudget.
+
+Each execution deposits the retryRatio into the budget, and each retry withdraws 1 from it. Deposits and withdrawals
+expire from the budget after its ttl. A reserve of minRetriesPerSecond retries is always available over the ttl, so
+that low traffic executions can still be retried.
+
+This type is concurrency safe.
+*/
+type RetryBudget interface {
+	// Deposit records an execution, which deposits the retryRatio into the budget.
+	Deposit()
+
+	// TryWithdraw tries to withdraw a retry from the budget, returning whether the retry is permitted.
+	TryWithdraw() bool
+
+	// Balance returns the number of retries that are currently available.
+	Balance() int
+}
+
+type retryBudget struct {
+	clock               util.Clock
+	retryRatio          float64
+	minRetriesPerSecond uint
+	ttl                 time.Duration
+
+	mtx sync.Mutex
+	// Guarded by mtx
+	deposits    *slidingCounter
+	withdrawals *slidingCounter
+}
+
+// NewRetryBudget returns a new RetryBudget that permits retries at the retryRatio of executions, such as 0.2 for 1 retry
+// for every 5 executions, plus a reserve of minRetriesPerSecond, over the ttl.
+func NewRetryBudget(retryRatio float64, minRetriesPerSecond uint, ttl time.Duration) RetryBudget {
+	return newRetryBudget(retryRatio, minRetriesPerSecond, ttl, util.NewClock())
+}
+
+func newRetryBudget(retryRatio float64, minRetriesPerSecond uint, ttl time.Duration, clock util.Clock) *retryBudget {
+	bucketSize := max(1, ttl.Nanoseconds()/budgetBuckets)
+	return &retryBudget{
+		clock:               clock,
+		retryRatio:          retryRatio,
+		minRetriesPerSecond: minRetriesPerSecond,
+		ttl:                 ttl,
+		deposits:            newSlidingCounter(budgetBuckets, bucketSize),
+		withdrawals:         newSlidingCounter(budgetBuckets, bucketSize),
+	}
+}
+
+func (b *retryBudget) Deposit() {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.deposits.add(b.clock.CurrentUnixNano())
+}
+
+func (b *retryBudget) TryWithdraw() bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	now := b.clock.CurrentUnixNano()
+	if b.balance(now) < 1 {
+		return false
+	}
+	b.withdrawals.add(now)
+	return true
+}
+
+func (b *retryBudget) Balance() int {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.balance(b.clock.CurrentUnixNano())
+}
+
+// Requires external locking.
+func (b *retryBudget) balance(now int64) int {
+	reserve := float64(b.minRetriesPerSecond) * b.ttl.Seconds()
+	deposited := b.retryRatio * float64(b.deposits.sum(now))
+	return max(0, int(reserve+deposited)-int(b.withdrawals.sum(now)))
+}
+
+// slidingCounter counts events within a sliding time window that is divided into buckets.
+//
+// This type is not concurrency safe.
+type slidingCounter struct {
+	bucketSize int64   // The size of each bucket, in nanoseconds
+	counts     []int64 // The count for each bucket
+	indexes    []int64 // The time index that each bucket's count is for
+}
+
+func newSlidingCounter(buckets int, bucketSize int64) *slidingCounter {
+	return &slidingCounter{
+		bucketSize: bucketSize,
+		counts:     make([]int64, buckets),
+		indexes:    make([]int64, buckets),
+	}
+}
+
+// add increments the count for the bucket at the time.
+func (c *slidingCounter) add(unixNano int64) {
+	index := unixNano / c.bucketSize
+	i := index % int64(len(c.counts))
+	if c.indexes[i] != index {
+		c.indexes[i] = index
+		c.counts[i] = 0
+	}
+	c.counts[i]++
+}
+
+// sum returns the sum of the counts for buckets that are still within the window at the time.
+func (c *slidingCounter) sum(unixNano int64) int64 {
+	index := unixNano / c.bucketSize
+	var result int64
+	for i, count := range c.counts {
+		if index-c.indexes[i] < int64(len(c.counts)) {
+			result += count
+		}
+	}
+	return result
+}
@@ -0,0 +1,44 @@
+package retrypolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+)
+
+func TestRetryBudget(t *testing.T) {
+	// Given
+	clock := &testutil.TestClock{}
+	budget := newRetryBudget(0.5, 1, 10*time.Second, clock)
+
+	// When / Then
+	assert.Equal(t, 10, budget.Balance(), "should have a reserve of minRetriesPerSecond over the ttl")
+	for i := 0; i < 4; i++ {
+		budget.Deposit()
+	}
+	assert.Equal(t, 12, budget.Balance())
+	for i := 0; i < 12; i++ {
+		assert.True(t, budget.TryWithdraw())
+	}
+	assert.False(t, budget.TryWithdraw())
+	assert.Equal(t, 0, budget.Balance())
+
+	// When / Then
+	clock.CurrentTime = (10 * time.Second).Nanoseconds()
+	assert.Equal(t, 10, budget.Balance(), "deposits and withdrawals should expire after the ttl")
+}
+
+func TestSlidingCounter(t *testing.T) {
+	counter := newSlidingCounter(4, time.Second.Nanoseconds())
+	counter.add(0)
+	counter.add((1 * time.Second).Nanoseconds())
+	counter.add((3 * time.Second).Nanoseconds())
+	assert.Equal(t, int64(3), counter.sum((3 * time.Second).Nanoseconds()))
+	assert.Equal(t, int64(2), counter.sum((4 * time.Second).Nanoseconds()))
+	counter.add((5 * time.Second).Nanoseconds())
+	assert.Equal(t, int64(2), counter.sum((5 * time.Second).Nanoseconds()))
+	assert.Equal(t, int64(0), counter.sum((9 * time.Second).Nanoseconds()))
+}
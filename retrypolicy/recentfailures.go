@@ -0,0 +1,51 @@
+package retrypolicy
+
+import (
+	"errors"
+	"sync"
+)
+
+// recentFailures tracks the errors that recent executions of a RetryPolicy failed with, in order to skip retries for
+// errors that are frequently occurring across executions.
+type recentFailures struct {
+	threshold int
+
+	mtx sync.Mutex
+	// Guarded by mtx
+	errs []error // A circular buffer of the errors for recent executions, with nil for successes
+	head int
+	size int
+}
+
+func newRecentFailures(window int, threshold int) *recentFailures {
+	return &recentFailures{
+		threshold: threshold,
+		errs:      make([]error, window),
+	}
+}
+
+// record records the err that an execution failed with, else nil if it succeeded.
+func (r *recentFailures) record(err error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.errs[r.head] = err
+	r.head = (r.head + 1) % len(r.errs)
+	r.size = min(r.size+1, len(r.errs))
+}
+
+// thresholdExceeded returns whether the err occurred in at least threshold of the recent executions, where errors are
+// considered the same if either matches the other using errors.Is.
+func (r *recentFailures) thresholdExceeded(err error) bool {
+	if err == nil {
+		return false
+	}
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	occurrences := 0
+	for i := 0; i < r.size; i++ {
+		if recentErr := r.errs[i]; recentErr != nil && (errors.Is(err, recentErr) || errors.Is(recentErr, err)) {
+			occurrences++
+		}
+	}
+	return occurrences >= r.threshold
+}
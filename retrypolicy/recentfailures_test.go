@@ -0,0 +1,31 @@
+package retrypolicy
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecentFailures(t *testing.T) {
+	// Given
+	err1 := errors.New("1")
+	err2 := errors.New("2")
+	rf := newRecentFailures(3, 2)
+
+	// When / Then
+	rf.record(err1)
+	assert.False(t, rf.thresholdExceeded(err1))
+	rf.record(nil)
+	rf.record(fmt.Errorf("wrapped: %w", err1))
+	assert.True(t, rf.thresholdExceeded(err1))
+	assert.False(t, rf.thresholdExceeded(err2))
+	assert.False(t, rf.thresholdExceeded(nil))
+
+	// Older executions leave the window
+	rf.record(err2)
+	assert.False(t, rf.thresholdExceeded(err1))
+	rf.record(err2)
+	assert.True(t, rf.thresholdExceeded(err2))
+}
@@ -1,10 +1,13 @@
 package retrypolicy
 
 import (
+	"errors"
 	"fmt"
+	"math/rand"
 	"time"
 
 	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/clock"
 	"github.com/failsafe-go/failsafe-go/policy"
 )
 
@@ -52,6 +55,17 @@ func (e *ExceededError) Is(err error) bool {
 // This type is concurrency safe.
 type RetryPolicy[R any] interface {
 	failsafe.Policy[R]
+
+	// DelaySchedule returns the delays that would be performed before each retry, after each of up to attempts failed
+	// attempts, based on the configured delay, backoff, and max retries. Jitter is not applied, and random delays use the
+	// upper bound of their range, so the schedule describes the longest delays that may be performed. Delays computed by a
+	// DelayFunc, delays from Execution.SetNextRetryTime, and the max duration are not accounted for, since they depend on
+	// individual executions. This does not affect the state of the RetryPolicy.
+	DelaySchedule(attempts int) []time.Duration
+
+	// DelayScheduleWithJitter returns the same delays as DelaySchedule, except that random delays and jitter are computed
+	// using the random source, which can be seeded to produce a reproducible schedule.
+	DelayScheduleWithJitter(attempts int, random *rand.Rand) []time.Duration
 }
 
 /*
@@ -67,7 +81,8 @@ RetryPolicyBuilder builds RetryPolicy instances.
     HandleResult or HandleResultIf will not replace the default error handling condition.
   - If multiple HandleErrors conditions are specified, any condition that matches an execution result or error will
     trigger policy handling.
-  - The AbortOn, AbortWhen and AbortIf methods describe when retries should be aborted.
+  - The AbortOn, AbortWhen and AbortIf methods describe when retries should be aborted. Retries are also aborted for
+    errors classified as failsafe.Fatal by a classifier registered via failsafe.SetErrorClassifier.
 
 This class extends failsafe.ListenablePolicyBuilder, failsafe.FailurePolicyBuilder and failsafe.DelayablePolicyBuilder
 which offer additional configuration.
@@ -104,20 +119,38 @@ type RetryPolicyBuilder[R any] interface {
 	WithMaxDuration(maxDuration time.Duration) RetryPolicyBuilder[R]
 
 	// WithBackoff wets the delay between retries, exponentially backing off to the maxDelay and multiplying consecutive
-	// delays by a factor of 2. Replaces any previously configured fixed or random delays.
+	// delays by a factor of 2. Replaces any previously configured fixed or random delays. Panics if the maxDelay is not
+	// greater than 0 and at least the delay.
 	WithBackoff(delay time.Duration, maxDelay time.Duration) RetryPolicyBuilder[R]
 
 	// WithBackoffFactor sets the delay between retries, exponentially backing off to the maxDelay and multiplying
-	// consecutive delays by the delayFactor. Replaces any previously configured fixed or random delays.
+	// consecutive delays by the delayFactor. Replaces any previously configured fixed or random delays. Panics if the
+	// maxDelay is not greater than 0 and at least the delay.
 	WithBackoffFactor(delay time.Duration, maxDelay time.Duration, delayFactor float32) RetryPolicyBuilder[R]
 
+	// WithFibonacciBackoff sets the delay between retries, backing off to the maxDelay according to the Fibonacci sequence,
+	// where each delay is the sum of the previous two delays. For example, a delay of 1 second results in delays of 1, 1,
+	// 2, 3, 5, and 8 seconds, up to the maxDelay. This backs off more gradually than exponential backoff. Replaces any
+	// previously configured fixed or random delays. Panics if the maxDelay is not greater than 0 and at least the delay.
+	WithFibonacciBackoff(delay time.Duration, maxDelay time.Duration) RetryPolicyBuilder[R]
+
+	// WithErrorBackoff sets backoff delays to use for retries after specific errors, where the delay before a retry is
+	// determined by the error of the attempt that failed. Errors are matched against the map's keys using errors.Is. If
+	// multiple entries match an error, the entry with the longest Delay is used, or the longest MaxDelay if the Delays are
+	// equal, so that the most conservative backoff applies. When no entry matches, the policy's configured delay or backoff
+	// is used. Each entry backs off separately, based on the previous delay for that entry during the execution.
+	//
+	// A delay set via WithDelayFunc or Execution.SetNextRetryTime takes precedence over an error backoff. Jitter and any max
+	// duration are applied to error backoff delays.
+	WithErrorBackoff(backoffs map[error]BackoffConfig) RetryPolicyBuilder[R]
+
 	// WithRandomDelay sets a random delay between the delayMin and delayMax (inclusive) to occur between retries.
 	// Replaces any previously configured delay or backoff delay.
 	WithRandomDelay(delayMin time.Duration, delayMax time.Duration) RetryPolicyBuilder[R]
 
 	// WithJitter sets the jitter to randomly vary retry delays by. For each retry delay, a random portion of the jitter will
 	// be added or subtracted to the delay. For example: a jitter of 100 milliseconds will randomly add between -100 and 100
-	// milliseconds to each retry delay. Replaces any previously configured jitter factor.
+	// milliseconds to each retry delay. Replaces any previously configured jitter factor or jitter strategy.
 	//
 	// Jitter should be combined with fixed, random, or exponential backoff delays. If no delays are configured, this setting
 	// is ignored.
@@ -126,12 +159,53 @@ type RetryPolicyBuilder[R any] interface {
 	// WithJitterFactor sets the jitterFactor to randomly vary retry delays by. For each retry delay, a random portion of the
 	// delay multiplied by the jitterFactor will be added or subtracted to the delay. For example: a retry delay of 100
 	// milliseconds and a jitterFactor of .25 will result in a random retry delay between 75 and 125 milliseconds. Replaces
-	// any previously configured jitter duration.
+	// any previously configured jitter duration or jitter strategy.
 	//
 	// Jitter should be combined with fixed, random, or exponential backoff delays. If no delays are configured, this setting
 	// is ignored.
 	WithJitterFactor(jitterFactor float32) RetryPolicyBuilder[R]
 
+	// WithJitterStrategy sets the strategy to randomly vary retry delays by. See failsafe.JitterStrategy for the formula
+	// that each strategy uses. With failsafe.JitterDecorrelated, each retry delay is based on the previous jittered delay,
+	// and delays are bounded by any configured max delay. Replaces any previously configured jitter duration or jitter
+	// factor.
+	//
+	// Jitter should be combined with fixed, random, or exponential backoff delays. If no delays are configured, this setting
+	// is ignored.
+	WithJitterStrategy(strategy failsafe.JitterStrategy) RetryPolicyBuilder[R]
+
+	// WithRecentFailureAbort configures the policy to skip retries for an error that has occurred in at least threshold of
+	// the last window executions of the policy, where errors are considered the same if either matches the other using
+	// errors.Is. This provides a lightweight alternative to a CircuitBreaker, avoiding retries for errors that are
+	// persistently occurring. The recent executions are tracked by the RetryPolicy instance and shared across all
+	// executions that use it, including concurrent executions. When retries are skipped, the execution is aborted, and any
+	// OnAbort listener is called.
+	WithRecentFailureAbort(window int, threshold int) RetryPolicyBuilder[R]
+
+	// WithStateStore configures a StateStore that is used to persist the RetryState of executions, allowing retries to
+	// resume after a process restart rather than starting over from the first attempt. State is only persisted for
+	// executions whose context contains a key, via ContextWithStateKey. When an execution is started with a key that has
+	// stored state, the failed attempts and last delay are restored, and the execution waits until any stored next attempt
+	// time before performing its next attempt.
+	//
+	// State is saved after each failed attempt, before the retry delay, and is deleted once the execution completes or
+	// retries are exceeded. State is retained if the execution is canceled. Since a process may stop after an attempt is
+	// performed but before its state is saved, attempts may be repeated after a restart, so the execution's func must be
+	// idempotent. The StateStore is not coordinated across processes, so concurrent executions with the same key will
+	// overwrite each other's state.
+	WithStateStore(store StateStore) RetryPolicyBuilder[R]
+
+	// WithBudget configures a RetryBudget that limits retries to a ratio of executions. The budget can be shared by multiple
+	// RetryPolicies, so that retries across them are collectively limited. Each execution deposits into the budget, and
+	// each retry withdraws from it. When the budget is exhausted, retries are exceeded and the OnRetriesExceeded listener is
+	// called.
+	WithBudget(budget RetryBudget) RetryPolicyBuilder[R]
+
+	// WithClock configures the clock that the RetryPolicy waits for delays with, and measures the max duration and delays
+	// until a next retry time with. This is useful for testing delays without waiting for them, via a clock.FakeClock. The
+	// default is clock.New().
+	WithClock(clock clock.Clock) RetryPolicyBuilder[R]
+
 	// OnAbort registers the listener to be called when an execution is aborted.
 	OnAbort(listener func(failsafe.ExecutionEvent[R])) RetryPolicyBuilder[R]
 
@@ -151,6 +225,28 @@ type RetryPolicyBuilder[R any] interface {
 	Build() RetryPolicy[R]
 }
 
+// BackoffConfig configures a backoff delay. See RetryPolicyBuilder.WithErrorBackoff.
+type BackoffConfig struct {
+	// The initial delay
+	Delay time.Duration
+	// The max delay to back off to. If 0, the Delay is used for each retry without backing off.
+	MaxDelay time.Duration
+	// The factor to multiply consecutive delays by. If 0, a factor of 2 is used.
+	DelayFactor float32
+}
+
+// next returns the delay that follows the lastDelay, else the initial delay if lastDelay is 0.
+func (b BackoffConfig) next(lastDelay time.Duration) time.Duration {
+	if lastDelay == 0 || b.MaxDelay == 0 {
+		return b.Delay
+	}
+	delayFactor := b.DelayFactor
+	if delayFactor == 0 {
+		delayFactor = 2
+	}
+	return min(time.Duration(float32(lastDelay)*delayFactor), b.MaxDelay)
+}
+
 type retryPolicyConfig[R any] struct {
 	*policy.BaseFailurePolicy[R]
 	*policy.BaseDelayablePolicy[R]
@@ -160,11 +256,20 @@ type retryPolicyConfig[R any] struct {
 	delayMin          time.Duration
 	delayMax          time.Duration
 	delayFactor       float32
+	fibonacci         bool
 	maxDelay          time.Duration
 	jitter            time.Duration
 	jitterFactor      float32
+	jitterStrategy    failsafe.JitterStrategy
 	maxDuration       time.Duration
 	maxRetries        int
+	stateStore        StateStore
+	budget            RetryBudget
+	clock             clock.Clock
+	errorBackoffs     map[error]BackoffConfig
+
+	recentFailureWindow    int
+	recentFailureThreshold int
 
 	onAbort           func(failsafe.ExecutionEvent[R])
 	onRetry           func(failsafe.ExecutionEvent[R])
@@ -176,6 +281,9 @@ var _ RetryPolicyBuilder[any] = &retryPolicyConfig[any]{}
 
 type retryPolicy[R any] struct {
 	config *retryPolicyConfig[R]
+
+	// Shared across executions
+	recentFailures *recentFailures
 }
 
 // WithDefaults creates a RetryPolicy for execution result type R that allows 3 execution attempts max with no delay. To
@@ -192,14 +300,19 @@ func Builder[R any]() RetryPolicyBuilder[R] {
 		BaseDelayablePolicy: &policy.BaseDelayablePolicy[R]{},
 		BaseAbortablePolicy: &policy.BaseAbortablePolicy[R]{},
 		maxRetries:          defaultMaxRetries,
+		clock:               clock.New(),
 	}
 }
 
 func (c *retryPolicyConfig[R]) Build() RetryPolicy[R] {
 	rpCopy := *c
-	return &retryPolicy[R]{
+	rp := &retryPolicy[R]{
 		config: &rpCopy, // TODO copy base fields
 	}
+	if c.recentFailureWindow > 0 {
+		rp.recentFailures = newRecentFailures(c.recentFailureWindow, c.recentFailureThreshold)
+	}
+	return rp
 }
 
 func (c *retryPolicyConfig[R]) AbortOnResult(result R) RetryPolicyBuilder[R] {
@@ -271,9 +384,11 @@ func (c *retryPolicyConfig[R]) WithBackoff(delay time.Duration, maxDelay time.Du
 }
 
 func (c *retryPolicyConfig[R]) WithBackoffFactor(delay time.Duration, maxDelay time.Duration, delayFactor float32) RetryPolicyBuilder[R] {
+	validateBackoff(delay, maxDelay)
 	c.BaseDelayablePolicy.WithDelay(delay)
 	c.maxDelay = maxDelay
 	c.delayFactor = delayFactor
+	c.fibonacci = false
 
 	// Clear random delay
 	c.delayMin = 0
@@ -281,6 +396,48 @@ func (c *retryPolicyConfig[R]) WithBackoffFactor(delay time.Duration, maxDelay t
 	return c
 }
 
+func (c *retryPolicyConfig[R]) WithFibonacciBackoff(delay time.Duration, maxDelay time.Duration) RetryPolicyBuilder[R] {
+	c.WithBackoffFactor(delay, maxDelay, 0)
+	c.fibonacci = true
+	return c
+}
+
+// validateBackoff panics if the maxDelay is not positive or is less than the delay, since either would cap every backoff
+// delay at the maxDelay.
+func validateBackoff(delay time.Duration, maxDelay time.Duration) {
+	if maxDelay <= 0 {
+		panic("retrypolicy: maxDelay must be greater than 0")
+	}
+	if maxDelay < delay {
+		panic("retrypolicy: maxDelay must be greater than or equal to delay")
+	}
+}
+
+func (c *retryPolicyConfig[R]) WithErrorBackoff(backoffs map[error]BackoffConfig) RetryPolicyBuilder[R] {
+	c.errorBackoffs = backoffs
+	return c
+}
+
+// errorBackoff returns the key and BackoffConfig of the errorBackoffs entry that matches the err, if any. If multiple
+// entries match, the one with the longest delay is returned.
+func (c *retryPolicyConfig[R]) errorBackoff(err error) (error, BackoffConfig, bool) {
+	var matchedErr error
+	var matched BackoffConfig
+	var ok bool
+	if err == nil {
+		return nil, matched, false
+	}
+	for backoffErr, backoff := range c.errorBackoffs {
+		if !errors.Is(err, backoffErr) {
+			continue
+		}
+		if !ok || backoff.Delay > matched.Delay || (backoff.Delay == matched.Delay && backoff.MaxDelay > matched.MaxDelay) {
+			matchedErr, matched, ok = backoffErr, backoff, true
+		}
+	}
+	return matchedErr, matched, ok
+}
+
 func (c *retryPolicyConfig[R]) WithRandomDelay(delayMin time.Duration, delayMax time.Duration) RetryPolicyBuilder[R] {
 	c.delayMin = delayMin
 	c.delayMax = delayMax
@@ -288,16 +445,47 @@ func (c *retryPolicyConfig[R]) WithRandomDelay(delayMin time.Duration, delayMax
 	// Clear non-random delay
 	c.Delay = 0
 	c.maxDelay = 0
+	c.fibonacci = false
 	return c
 }
 
 func (c *retryPolicyConfig[R]) WithJitter(jitter time.Duration) RetryPolicyBuilder[R] {
 	c.jitter = jitter
+	c.jitterStrategy = failsafe.JitterNone
 	return c
 }
 
 func (c *retryPolicyConfig[R]) WithJitterFactor(jitterFactor float32) RetryPolicyBuilder[R] {
 	c.jitterFactor = jitterFactor
+	c.jitterStrategy = failsafe.JitterNone
+	return c
+}
+
+func (c *retryPolicyConfig[R]) WithJitterStrategy(strategy failsafe.JitterStrategy) RetryPolicyBuilder[R] {
+	c.jitterStrategy = strategy
+	c.jitter = 0
+	c.jitterFactor = 0
+	return c
+}
+
+func (c *retryPolicyConfig[R]) WithRecentFailureAbort(window int, threshold int) RetryPolicyBuilder[R] {
+	c.recentFailureWindow = window
+	c.recentFailureThreshold = threshold
+	return c
+}
+
+func (c *retryPolicyConfig[R]) WithStateStore(store StateStore) RetryPolicyBuilder[R] {
+	c.stateStore = store
+	return c
+}
+
+func (c *retryPolicyConfig[R]) WithBudget(budget RetryBudget) RetryPolicyBuilder[R] {
+	c.budget = budget
+	return c
+}
+
+func (c *retryPolicyConfig[R]) WithClock(clock clock.Clock) RetryPolicyBuilder[R] {
+	c.clock = clock
 	return c
 }
 
@@ -335,6 +523,52 @@ func (c *retryPolicyConfig[R]) allowsRetries() bool {
 	return c.maxRetries == -1 || c.maxRetries > 0
 }
 
+func (rp *retryPolicy[R]) DelaySchedule(attempts int) []time.Duration {
+	return rp.delaySchedule(attempts, func() float64 { return 1 }, false)
+}
+
+func (rp *retryPolicy[R]) DelayScheduleWithJitter(attempts int, random *rand.Rand) []time.Duration {
+	return rp.delaySchedule(attempts, random.Float64, true)
+}
+
+// delaySchedule computes the delays for up to attempts failed attempts the same way that a retryPolicyExecutor would.
+func (rp *retryPolicy[R]) delaySchedule(attempts int, random func() float64, withJitter bool) []time.Duration {
+	if rp.config.maxRetries != -1 {
+		attempts = min(attempts, rp.config.maxRetries)
+	}
+	schedule := make([]time.Duration, 0, max(0, attempts))
+	var lastDelay, lastJitterDelay time.Duration
+	for attempt := 1; attempt <= attempts; attempt++ {
+		lastDelay = getFixedOrRandomDelay(rp.config, lastDelay, random)
+		lastDelay = adjustForBackoff(rp.config, attempt, lastDelay)
+		delay := lastDelay
+		if withJitter && delay != 0 {
+			delay = adjustForJitter(rp.config, delay, lastJitterDelay, random)
+			lastJitterDelay = delay
+		}
+		schedule = append(schedule, delay)
+	}
+	return schedule
+}
+
+// DescribeConfig describes the RetryPolicy's configuration for failsafe.Executor.ConfigJSON.
+func (rp *retryPolicy[R]) DescribeConfig() map[string]any {
+	return map[string]any{
+		"maxRetries":        rp.config.maxRetries,
+		"maxDuration":       rp.config.maxDuration.String(),
+		"delay":             rp.config.Delay.String(),
+		"delayMin":          rp.config.delayMin.String(),
+		"delayMax":          rp.config.delayMax.String(),
+		"maxDelay":          rp.config.maxDelay.String(),
+		"delayFactor":       rp.config.delayFactor,
+		"fibonacci":         rp.config.fibonacci,
+		"jitter":            rp.config.jitter.String(),
+		"jitterFactor":      rp.config.jitterFactor,
+		"jitterStrategy":    rp.config.jitterStrategy.String(),
+		"returnLastFailure": rp.config.returnLastFailure,
+	}
+}
+
 func (rp *retryPolicy[R]) ToExecutor(_ R) any {
 	rpe := &retryPolicyExecutor[R]{
 		BaseExecutor: &policy.BaseExecutor[R]{
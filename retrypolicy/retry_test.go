@@ -2,7 +2,9 @@ package retrypolicy
 
 import (
 	"errors"
+	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -21,3 +23,79 @@ func TestRetriesExceededErrorComparison(t *testing.T) {
 	}
 	assert.ErrorIs(t, e1, e2)
 }
+
+func TestDelaySchedule(t *testing.T) {
+	t.Run("with backoff", func(t *testing.T) {
+		rp := Builder[any]().
+			WithMaxRetries(5).
+			WithBackoff(time.Second, 10*time.Second).
+			Build()
+		assert.Equal(t, []time.Duration{
+			time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second,
+		}, rp.DelaySchedule(10))
+		assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, rp.DelaySchedule(2))
+	})
+
+	t.Run("with fixed delay", func(t *testing.T) {
+		rp := Builder[any]().
+			WithDelay(time.Second).
+			Build()
+		assert.Equal(t, []time.Duration{time.Second, time.Second}, rp.DelaySchedule(5))
+	})
+
+	t.Run("with fibonacci backoff", func(t *testing.T) {
+		rp := Builder[any]().
+			WithMaxRetries(-1).
+			WithFibonacciBackoff(time.Second, 5*time.Second).
+			Build()
+		assert.Equal(t, []time.Duration{
+			time.Second, time.Second, 2 * time.Second, 3 * time.Second, 5 * time.Second, 5 * time.Second,
+		}, rp.DelaySchedule(6))
+	})
+
+	t.Run("with random delay", func(t *testing.T) {
+		rp := Builder[any]().
+			WithRandomDelay(time.Second, 3*time.Second).
+			Build()
+		assert.Equal(t, []time.Duration{3 * time.Second, 3 * time.Second}, rp.DelaySchedule(2))
+	})
+
+	t.Run("with seeded jitter", func(t *testing.T) {
+		rp := Builder[any]().
+			WithMaxRetries(3).
+			WithBackoff(time.Second, 10*time.Second).
+			WithJitter(100 * time.Millisecond).
+			Build()
+		schedule := rp.DelayScheduleWithJitter(3, rand.New(rand.NewSource(1)))
+		assert.Equal(t, schedule, rp.DelayScheduleWithJitter(3, rand.New(rand.NewSource(1))))
+		for i, expected := range rp.DelaySchedule(3) {
+			assert.InDelta(t, expected, schedule[i], float64(100*time.Millisecond))
+		}
+	})
+}
+
+func TestBackoffValidation(t *testing.T) {
+	t.Run("fibonacci with zero max delay", func(t *testing.T) {
+		assert.Panics(t, func() {
+			Builder[any]().WithFibonacciBackoff(time.Second, 0)
+		})
+	})
+
+	t.Run("fibonacci with max delay less than delay", func(t *testing.T) {
+		assert.Panics(t, func() {
+			Builder[any]().WithFibonacciBackoff(time.Second, time.Millisecond)
+		})
+	})
+
+	t.Run("exponential with zero max delay", func(t *testing.T) {
+		assert.Panics(t, func() {
+			Builder[any]().WithBackoff(time.Second, 0)
+		})
+	})
+
+	t.Run("max delay equal to delay", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			Builder[any]().WithFibonacciBackoff(time.Second, time.Second)
+		})
+	})
+}
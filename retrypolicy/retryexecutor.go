@@ -19,7 +19,11 @@ type retryPolicyExecutor[R any] struct {
 	// Mutable state
 	failedAttempts  int
 	retriesExceeded bool
-	lastDelay       time.Duration // The last fixed, backoff, random, or computed delay time
+	lastDelay       time.Duration           // The last fixed, backoff, random, or computed delay time
+	lastJitterDelay time.Duration           // The last delay with a jitter strategy applied
+	resumedAttempts int                     // The number of attempts restored from a StateStore
+	startTime       time.Time               // The time the execution started, according to the clock
+	errorDelays     map[error]time.Duration // The last delay for each error backoff that was used
 }
 
 var _ policy.Executor[any] = &retryPolicyExecutor[any]{}
@@ -28,8 +32,32 @@ func (e *retryPolicyExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *comm
 	return func(exec failsafe.Execution[R]) *common.PolicyResult[R] {
 		execInternal := exec.(policy.ExecutionInternal[R])
 
+		// Measure the elapsed time via the clock, from when the execution started
+		e.startTime = e.config.clock.Now().Add(-exec.ElapsedTime())
+
+		// Provide the max duration as a budget deadline, so that inner policies such as a Timeout can bound attempts by it
+		if e.config.maxDuration != 0 {
+			exec = execInternal.CopyWithBudgetDeadline(exec.StartTime().Add(e.config.maxDuration))
+			execInternal = exec.(policy.ExecutionInternal[R])
+		}
+
+		if e.config.budget != nil {
+			e.config.budget.Deposit()
+		}
+
+		// Resume from any stored state
+		var stateKey string
+		if e.config.stateStore != nil {
+			stateKey, _ = stateKeyFromContext(exec.Context())
+		}
+		if stateKey != "" {
+			if cancelResult := e.resume(execInternal, stateKey); cancelResult != nil {
+				return cancelResult
+			}
+		}
+
 		for {
-			result := innerFn(exec)
+			result := innerFn(execInternal.CopyWithRetriesRemaining(e.hasRetriesRemaining()))
 			if canceled, cancelResult := execInternal.IsCanceledWithResult(); canceled {
 				return cancelResult
 			}
@@ -37,8 +65,18 @@ func (e *retryPolicyExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *comm
 				return result
 			}
 
+			attemptErr := result.Error
 			result = e.PostExecute(execInternal, result)
 			if result.Done {
+				if e.recentFailures != nil {
+					if result.Success {
+						attemptErr = nil
+					}
+					e.recentFailures.record(attemptErr)
+				}
+				if stateKey != "" {
+					e.config.stateStore.Delete(stateKey)
+				}
 				return result
 			}
 
@@ -48,16 +86,29 @@ func (e *retryPolicyExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *comm
 			}
 
 			// Delay
-			delay := e.getDelay(exec)
+			delay := e.getDelay(execInternal, result.Error)
+			if stateKey != "" {
+				e.config.stateStore.Save(stateKey, RetryState{
+					FailedAttempts:  e.failedAttempts,
+					LastDelay:       e.lastDelay,
+					NextAttemptTime: e.config.clock.Now().Add(delay),
+				})
+			}
+			if metrics := execInternal.Metrics(); metrics != nil {
+				metrics.IncrementCounter(failsafe.MetricRetries, nil)
+			}
+			if logger := execInternal.Logger(); logger != nil {
+				logger.Debug("retry scheduled", "policy", "RetryPolicy", "attempt", exec.Attempts(), "delay", delay, "error", result.Error)
+			}
 			if e.config.onRetryScheduled != nil {
 				e.config.onRetryScheduled(failsafe.ExecutionScheduledEvent[R]{
 					ExecutionAttempt: execInternal.CopyWithResult(result),
 					Delay:            delay,
 				})
 			}
-			timer := time.NewTimer(delay)
+			timer := e.config.clock.NewTimer(delay)
 			select {
-			case <-timer.C:
+			case <-timer.C():
 			case <-exec.Canceled():
 				timer.Stop()
 			}
@@ -75,18 +126,55 @@ func (e *retryPolicyExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *comm
 	}
 }
 
+// resume restores the failedAttempts and lastDelay from any state stored for the stateKey, and waits until the stored
+// next attempt time. Returns a cancel result if the execution is canceled while waiting.
+func (e *retryPolicyExecutor[R]) resume(exec policy.ExecutionInternal[R], stateKey string) *common.PolicyResult[R] {
+	state, ok := e.config.stateStore.Load(stateKey)
+	if !ok {
+		return nil
+	}
+	e.failedAttempts = state.FailedAttempts
+	e.resumedAttempts = state.FailedAttempts
+	e.lastDelay = state.LastDelay
+	if delay := state.NextAttemptTime.Sub(e.config.clock.Now()); delay > 0 {
+		timer := e.config.clock.NewTimer(delay)
+		select {
+		case <-timer.C():
+		case <-exec.Canceled():
+			timer.Stop()
+		}
+	}
+	if canceled, cancelResult := exec.IsCanceledWithResult(); canceled {
+		return cancelResult
+	}
+	return nil
+}
+
 // OnFailure updates failedAttempts and retriesExceeded, and calls event listeners
 func (e *retryPolicyExecutor[R]) OnFailure(exec policy.ExecutionInternal[R], result *common.PolicyResult[R]) *common.PolicyResult[R] {
 	e.BaseExecutor.OnFailure(exec, result)
 
 	e.failedAttempts++
 	maxRetriesExceeded := e.config.maxRetries != -1 && e.failedAttempts > e.config.maxRetries
-	maxDurationExceeded := e.config.maxDuration != 0 && exec.ElapsedTime() > e.config.maxDuration
+	maxDurationExceeded := e.config.maxDuration != 0 && e.elapsedTime() > e.config.maxDuration
+	isAbortable := e.config.IsAbortable(result.Result, result.Error) ||
+		(e.recentFailures != nil && e.recentFailures.thresholdExceeded(result.Error))
 	e.retriesExceeded = maxRetriesExceeded || maxDurationExceeded
-	isAbortable := e.config.IsAbortable(result.Result, result.Error)
+	if !e.retriesExceeded && !isAbortable && e.config.allowsRetries() && e.config.budget != nil {
+		// Only withdraw from the budget when a retry would otherwise be performed
+		e.retriesExceeded = !e.config.budget.TryWithdraw()
+	}
 	shouldRetry := !isAbortable && !e.retriesExceeded && e.config.allowsRetries()
 	done := isAbortable || !shouldRetry
 
+	if logger := exec.Logger(); logger != nil {
+		if isAbortable {
+			logger.Debug("retries aborted", "policy", "RetryPolicy", "attempt", exec.Attempts(), "error", result.Error)
+		} else if e.retriesExceeded {
+			logger.Debug("retries exceeded", "policy", "RetryPolicy", "attempt", exec.Attempts(), "error", result.Error)
+		}
+	}
+
 	// Call listeners
 	if isAbortable && e.config.onAbort != nil {
 		e.config.onAbort(failsafe.ExecutionEvent[R]{ExecutionAttempt: exec.CopyWithResult(result)})
@@ -105,47 +193,99 @@ func (e *retryPolicyExecutor[R]) OnFailure(exec policy.ExecutionInternal[R], res
 	return result.WithDone(done, false)
 }
 
-// getDelay updates lastDelay and returns the new delay
-func (e *retryPolicyExecutor[R]) getDelay(exec failsafe.ExecutionAttempt[R]) time.Duration {
+// hasRetriesRemaining returns whether the current attempt may be retried if it fails, based on the max retries, max
+// duration, and any budget's current balance.
+func (e *retryPolicyExecutor[R]) hasRetriesRemaining() bool {
+	if !e.config.allowsRetries() {
+		return false
+	}
+	maxRetriesUsed := e.config.maxRetries != -1 && e.failedAttempts >= e.config.maxRetries
+	maxDurationElapsed := e.config.maxDuration != 0 && e.elapsedTime() >= e.config.maxDuration
+	budgetExhausted := e.config.budget != nil && e.config.budget.Balance() < 1
+	return !maxRetriesUsed && !maxDurationElapsed && !budgetExhausted
+}
+
+// getDelay updates lastDelay and returns the new delay, where err is the error from the last attempt. If a next retry time
+// was set on the execution, the delay until that time is returned instead, bounded by any max delay.
+func (e *retryPolicyExecutor[R]) getDelay(exec policy.ExecutionInternal[R], err error) time.Duration {
+	if nextRetryTime, ok := exec.NextRetryTime(); ok {
+		delay := nextRetryTime.Sub(e.config.clock.Now())
+		if e.config.maxDelay != 0 {
+			delay = min(delay, e.config.maxDelay)
+		}
+		return adjustForMaxDuration(e.config, delay, e.elapsedTime())
+	}
+
 	delay := e.lastDelay
 	computedDelay := e.config.ComputeDelay(exec)
 	if computedDelay != -1 {
 		delay = computedDelay
+	} else if backoffErr, backoff, ok := e.config.errorBackoff(err); ok {
+		if e.errorDelays == nil {
+			e.errorDelays = make(map[error]time.Duration)
+		}
+		delay = backoff.next(e.errorDelays[backoffErr])
+		e.errorDelays[backoffErr] = delay
 	} else {
-		delay = getFixedOrRandomDelay(e.config, delay)
-		delay = adjustForBackoff(e.config, exec, delay)
+		delay = getFixedOrRandomDelay(e.config, delay, rand.Float64)
+		delay = adjustForBackoff(e.config, exec.Attempts()+e.resumedAttempts, delay)
 		e.lastDelay = delay
 	}
 	if delay != 0 {
-		delay = adjustForJitter(e.config, delay)
+		delay = adjustForJitter(e.config, delay, e.lastJitterDelay, rand.Float64)
+		e.lastJitterDelay = delay
 	}
-	delay = adjustForMaxDuration(e.config, delay, exec.ElapsedTime())
+	delay = adjustForMaxDuration(e.config, delay, e.elapsedTime())
 	return delay
 }
 
-func getFixedOrRandomDelay[R any](config *retryPolicyConfig[R], delay time.Duration) time.Duration {
+// elapsedTime returns the time elapsed since the execution started, according to the clock.
+func (e *retryPolicyExecutor[R]) elapsedTime() time.Duration {
+	return e.config.clock.Since(e.startTime)
+}
+
+func getFixedOrRandomDelay[R any](config *retryPolicyConfig[R], delay time.Duration, random func() float64) time.Duration {
 	if delay == 0 && config.Delay != 0 {
 		return config.Delay
 	}
 	if config.delayMin != 0 && config.delayMax != 0 {
-		return time.Duration(util.RandomDelayInRange(config.delayMin.Nanoseconds(), config.delayMax.Nanoseconds(), rand.Float64()))
+		return time.Duration(util.RandomDelayInRange(config.delayMin.Nanoseconds(), config.delayMax.Nanoseconds(), random()))
 	}
 	return delay
 }
 
-func adjustForBackoff[R any](config *retryPolicyConfig[R], exec failsafe.ExecutionAttempt[R], delay time.Duration) time.Duration {
-	if exec.Attempts() != 1 && config.maxDelay != 0 {
+func adjustForBackoff[R any](config *retryPolicyConfig[R], attempts int, delay time.Duration) time.Duration {
+	if config.fibonacci {
+		return fibonacciDelay(config.Delay, config.maxDelay, attempts)
+	}
+	if attempts != 1 && config.maxDelay != 0 {
 		backoffDelay := time.Duration(float32(delay) * config.delayFactor)
 		delay = min(backoffDelay, config.maxDelay)
 	}
 	return delay
 }
 
-func adjustForJitter[R any](config *retryPolicyConfig[R], delay time.Duration) time.Duration {
-	if config.jitter != 0 {
-		delay = util.RandomDelay(delay, config.jitter, rand.Float64())
+// fibonacciDelay returns the delay for the attempts, where each delay is the sum of the previous two, up to the maxDelay.
+func fibonacciDelay(delay time.Duration, maxDelay time.Duration, attempts int) time.Duration {
+	var prevDelay time.Duration
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		prevDelay, delay = delay, prevDelay+delay
+	}
+	return min(delay, maxDelay)
+}
+
+// adjustForJitter returns the delay with any jitter applied, where previousDelay is the previous delay that jitter was
+// applied to, which is used by a decorrelated jitter strategy.
+func adjustForJitter[R any](config *retryPolicyConfig[R], delay time.Duration, previousDelay time.Duration, random func() float64) time.Duration {
+	if config.jitterStrategy != failsafe.JitterNone {
+		delay = config.jitterStrategy.Apply(delay, previousDelay, random())
+		if config.maxDelay != 0 {
+			delay = min(delay, config.maxDelay)
+		}
+	} else if config.jitter != 0 {
+		delay = util.RandomDelay(delay, config.jitter, random())
 	} else if config.jitterFactor != 0 {
-		delay = util.RandomDelayFactor(delay, config.jitterFactor, rand.Float32())
+		delay = util.RandomDelayFactor(delay, config.jitterFactor, float32(random()))
 	}
 	return delay
 }
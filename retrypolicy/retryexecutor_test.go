@@ -1,24 +1,23 @@
 package retrypolicy
 
 import (
+	"math/rand"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
-	"github.com/failsafe-go/failsafe-go/internal/testutil"
+	"github.com/failsafe-go/failsafe-go"
 )
 
 func TestAdjustForBackoff(t *testing.T) {
 	// Given
 	rpc := Builder[any]().WithBackoff(time.Second, 10*time.Second).(*retryPolicyConfig[any])
-	exec := &testutil.TestExecution[any]{
-		TheAttempts: 1,
-	}
+	attempts := 1
 	delay := rpc.Delay
 	f := func() time.Duration {
-		delay = adjustForBackoff(rpc, exec, delay)
-		exec.TheAttempts++
+		delay = adjustForBackoff(rpc, attempts, delay)
+		attempts++
 		return delay
 	}
 
@@ -29,3 +28,77 @@ func TestAdjustForBackoff(t *testing.T) {
 	assert.Equal(t, 8*time.Second, f())
 	assert.Equal(t, 10*time.Second, f())
 }
+
+func TestAdjustForFibonacciBackoff(t *testing.T) {
+	// Given
+	rpc := Builder[any]().WithFibonacciBackoff(time.Second, 10*time.Second).(*retryPolicyConfig[any])
+	attempts := 1
+	delay := rpc.Delay
+	f := func() time.Duration {
+		delay = adjustForBackoff(rpc, attempts, delay)
+		attempts++
+		return delay
+	}
+
+	// When / Then
+	assert.Equal(t, time.Second, f())
+	assert.Equal(t, time.Second, f())
+	assert.Equal(t, 2*time.Second, f())
+	assert.Equal(t, 3*time.Second, f())
+	assert.Equal(t, 5*time.Second, f())
+	assert.Equal(t, 8*time.Second, f())
+	assert.Equal(t, 10*time.Second, f())
+	assert.Equal(t, 10*time.Second, f())
+}
+
+func TestAdjustForJitterStrategy(t *testing.T) {
+	delay := 100 * time.Millisecond
+	tests := []struct {
+		strategy     failsafe.JitterStrategy
+		min          time.Duration
+		max          time.Duration
+		expectedMean time.Duration
+	}{
+		{failsafe.JitterNone, delay, delay, delay},
+		{failsafe.JitterFull, 0, delay, delay / 2},
+		{failsafe.JitterEqual, delay / 2, delay, delay * 3 / 4},
+		{failsafe.JitterDecorrelated, delay, 3 * delay, 2 * delay},
+	}
+	for _, tc := range tests {
+		t.Run(tc.strategy.String(), func(t *testing.T) {
+			// Given
+			rpc := Builder[any]().WithDelay(delay).WithJitterStrategy(tc.strategy).(*retryPolicyConfig[any])
+			random := rand.New(rand.NewSource(1))
+
+			// When
+			var total time.Duration
+			for i := 0; i < 10000; i++ {
+				jittered := adjustForJitter(rpc, delay, 0, random.Float64)
+
+				// Then
+				assert.GreaterOrEqual(t, jittered, tc.min)
+				assert.LessOrEqual(t, jittered, tc.max)
+				total += jittered
+			}
+			assert.InDelta(t, float64(tc.expectedMean), float64(total/10000), float64(delay)/20)
+		})
+	}
+
+	t.Run("decorrelated with max delay", func(t *testing.T) {
+		// Given
+		rp := Builder[any]().
+			WithBackoff(delay, time.Second).
+			WithJitterStrategy(failsafe.JitterDecorrelated).
+			WithMaxRetries(-1).
+			Build()
+
+		// When
+		schedule := rp.DelayScheduleWithJitter(1000, rand.New(rand.NewSource(1)))
+
+		// Then
+		for i, expected := range rp.DelaySchedule(1000) {
+			assert.GreaterOrEqual(t, schedule[i], expected)
+			assert.LessOrEqual(t, schedule[i], time.Second)
+		}
+	})
+}
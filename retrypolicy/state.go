@@ -0,0 +1,52 @@
+package retrypolicy
+
+import (
+	"context"
+	"time"
+)
+
+// RetryState is the state of a RetryPolicy execution that can be persisted to a StateStore, allowing retries to resume
+// after a process restart.
+type RetryState struct {
+	// FailedAttempts is the number of failed attempts that have been performed.
+	FailedAttempts int
+
+	// LastDelay is the last fixed, backoff, or random delay that was computed, before any jitter is applied.
+	LastDelay time.Duration
+
+	// NextAttemptTime is the time that the next attempt is scheduled to be performed.
+	NextAttemptTime time.Time
+}
+
+/*
+StateStore stores RetryState for executions, keyed by an execution key. See RetryPolicyBuilder.WithStateStore.
+
+Implementations must be concurrency safe.
+*/
+type StateStore interface {
+	// Load returns the RetryState for the key, if any.
+	Load(key string) (RetryState, bool)
+
+	// Save stores the RetryState for the key.
+	Save(key string, state RetryState)
+
+	// Delete deletes any RetryState for the key.
+	Delete(key string)
+}
+
+type stateKey struct{}
+
+// ContextWithStateKey returns a copy of the ctx that contains the key that a RetryPolicy will use to load and save its
+// RetryState, when the RetryPolicy is configured with a StateStore.
+func ContextWithStateKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, stateKey{}, key)
+}
+
+// stateKeyFromContext returns the state key for the ctx, if any.
+func stateKeyFromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+	key, ok := ctx.Value(stateKey{}).(string)
+	return key, ok
+}
@@ -0,0 +1,102 @@
+package failsafe
+
+import (
+	"reflect"
+	"sync"
+)
+
+// terminalErrors records the policy that produced each error during an execution, so that the policy that produced the
+// final error can be determined regardless of how concurrent attempts, such as hedges, are interleaved. Errors are
+// identified by identity, and errors of incomparable types are not recorded.
+type terminalErrors struct {
+	mtx     sync.Mutex
+	errs    []error
+	indexes []int // The 1-based index of the policy that most recently produced each of the errs
+}
+
+// record records that the policy with the 1-based index produced the err.
+func (t *terminalErrors) record(err error, index int) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	for i, e := range t.errs {
+		if e == err {
+			t.indexes[i] = index
+			return
+		}
+	}
+	t.errs = append(t.errs, err)
+	t.indexes = append(t.indexes, index)
+}
+
+// indexOf returns the 1-based index of the policy that produced the err, else 0 if it was not produced by a policy.
+func (t *terminalErrors) indexOf(err error) int {
+	if !isComparable(err) {
+		return 0
+	}
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	for i, e := range t.errs {
+		if e == err {
+			return t.indexes[i]
+		}
+	}
+	return 0
+}
+
+// innerErrors records the errors returned by the policies or func that a policy is composed around, during a single call
+// of the policy. Since a call may perform multiple attempts, errors from earlier attempts are cleared when an attempt
+// starts while no other attempts are in progress, so that only the errors of the latest attempt, along with any that ran
+// concurrently with it, such as hedges, are retained.
+type innerErrors struct {
+	mtx      sync.Mutex
+	inFlight int
+	done     bool // Whether any attempt is done
+	errs     []error
+}
+
+// start records that an attempt started.
+func (e *innerErrors) start() {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	if e.inFlight == 0 {
+		e.errs = e.errs[:0]
+	}
+	e.inFlight++
+}
+
+// finish records that an attempt finished with the err.
+func (e *innerErrors) finish(err error) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	e.inFlight--
+	e.done = true
+	if err != nil && isComparable(err) {
+		e.errs = append(e.errs, err)
+	}
+}
+
+// contains returns whether any attempt is done, and whether the err was returned by a retained attempt.
+func (e *innerErrors) contains(err error) (done bool, found bool) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	for _, innerErr := range e.errs {
+		if innerErr == err {
+			return e.done, true
+		}
+	}
+	return e.done, false
+}
+
+// isSameError returns whether err1 and err2 are the identical error. Errors of incomparable types are never considered
+// the same, since their identity cannot be determined.
+func isSameError(err1 error, err2 error) bool {
+	if err1 == nil || err2 == nil {
+		return err1 == err2
+	}
+	return isComparable(err1) && err1 == err2
+}
+
+// isComparable returns whether the err's type is comparable, so that it can be compared via == without panicking.
+func isComparable(err error) bool {
+	return reflect.TypeOf(err).Comparable()
+}
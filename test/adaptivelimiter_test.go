@@ -0,0 +1,63 @@
+package test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/adaptivelimiter"
+)
+
+// Asserts that executions which exceed the limit are rejected, and that permits are released when executions complete.
+func TestAdaptiveLimiterRejectsWhenLimitExceeded(t *testing.T) {
+	// Given
+	rejections := 0
+	limiter := adaptivelimiter.Builder[string]().
+		WithInitialLimit(2).
+		OnLimitExceeded(func(e failsafe.ExecutionEvent[string]) {
+			rejections++
+		}).
+		Build()
+	executor := failsafe.NewExecutor[string](limiter)
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			executor.Get(func() (string, error) {
+				started <- struct{}{}
+				<-release
+				return "done", nil
+			})
+		}()
+	}
+	<-started
+	<-started
+
+	// When
+	_, err := executor.Get(func() (string, error) {
+		return "rejected", nil
+	})
+
+	// Then
+	assert.ErrorIs(t, err, adaptivelimiter.ErrLimitExceeded)
+	assert.Equal(t, 1, rejections)
+	assert.Equal(t, 2, limiter.Inflight())
+
+	// When
+	close(release)
+	wg.Wait()
+	result, err := executor.Get(func() (string, error) {
+		return "success", nil
+	})
+
+	// Then
+	assert.Equal(t, "success", result)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, limiter.Inflight())
+	assert.Greater(t, limiter.Limit(), 2)
+}
@@ -0,0 +1,75 @@
+package test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/adaptiveratelimiter"
+)
+
+var errThrottled = errors.New("429 too many requests")
+
+// Asserts that an AdaptiveRateLimiter backs off when executions are throttled, then recovers when they're not.
+func TestAdaptiveRateLimiterBacksOffAndRecovers(t *testing.T) {
+	// Given
+	arl := adaptiveratelimiter.Builder[any]().
+		WithInitialRate(1000).
+		WithMinRate(100).
+		WithMaxRate(2000).
+		WithIncrease(100).
+		WithMaxWaitTime(time.Second).
+		ThrottleIf(func(_ any, err error) bool {
+			return errors.Is(err, errThrottled)
+		}).
+		Build()
+	executor := failsafe.NewExecutor[any](arl)
+	run := func(times int, err error) {
+		for i := 0; i < times; i++ {
+			assert.ErrorIs(t, executor.Run(func() error {
+				return err
+			}), err)
+		}
+	}
+
+	// When throttled
+	run(2, errThrottled)
+
+	// Then
+	assert.Equal(t, float64(250), arl.Rate())
+
+	// When throttled past the min rate
+	run(2, errThrottled)
+
+	// Then
+	assert.Equal(t, float64(100), arl.Rate())
+
+	// When not throttled
+	run(5, nil)
+
+	// Then
+	assert.Equal(t, float64(600), arl.Rate())
+
+	// When not throttled past the max rate
+	run(20, nil)
+
+	// Then
+	assert.Equal(t, float64(2000), arl.Rate())
+}
+
+// Asserts that an AdaptiveRateLimiter rejects executions that exceed the current rate.
+func TestAdaptiveRateLimiterRejectsWhenExceeded(t *testing.T) {
+	// Given
+	arl := adaptiveratelimiter.Builder[any]().WithMaxRate(10).Build()
+
+	// When / Then
+	assert.True(t, arl.TryAcquirePermit())
+	assert.ErrorIs(t, failsafe.Run(func() error {
+		return nil
+	}, arl), adaptiveratelimiter.ErrExceeded)
+	time.Sleep(100 * time.Millisecond)
+	assert.True(t, arl.TryAcquirePermit())
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
 	"github.com/failsafe-go/failsafe-go/retrypolicy"
 )
 
@@ -23,3 +24,43 @@ func TestGetAsync(t *testing.T) {
 	assert.True(t, result.Result())
 	assert.Nil(t, result.Error())
 }
+
+// Asserts that an Executor's async executions return an ExecutionResult that can be joined later or canceled.
+func TestExecutorAsync(t *testing.T) {
+	// Given
+	rp := retrypolicy.Builder[bool]().WithDelay(10 * time.Millisecond).Build()
+	executor := failsafe.NewExecutor[bool](rp)
+
+	t.Run("joined", func(t *testing.T) {
+		// When
+		stub, _ := testutil.ErrorNTimesThenReturn[bool](testutil.ErrInvalidState, 2, true)
+		result := executor.GetWithExecutionAsync(stub)
+		runResult := executor.RunAsync(testutil.RunFn(testutil.ErrInvalidArgument))
+
+		// Then
+		<-result.Done()
+		assert.True(t, result.Result())
+		assert.NoError(t, result.Error())
+		<-runResult.Done()
+		assert.ErrorIs(t, runResult.Error(), retrypolicy.ErrExceeded)
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		// Given
+		started := make(chan struct{})
+
+		// When
+		result := executor.GetWithExecutionAsync(func(exec failsafe.Execution[bool]) (bool, error) {
+			close(started)
+			<-exec.Canceled()
+			return true, nil
+		})
+		<-started
+		result.Cancel()
+
+		// Then
+		<-result.Done()
+		assert.False(t, result.Result())
+		assert.ErrorIs(t, result.Error(), failsafe.ErrExecutionCanceled)
+	})
+}
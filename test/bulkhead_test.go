@@ -61,3 +61,62 @@ func TestBulkheadMaxWaitTimeExceeded(t *testing.T) {
 		return nil
 	}, 1, 0, bulkhead.ErrFull)
 }
+
+// Asserts that OnRejected is called with the reason an execution was rejected.
+func TestBulkheadRejectionReasons(t *testing.T) {
+	tests := []struct {
+		name           string
+		maxWaitTime    time.Duration
+		ctxTimeout     time.Duration
+		expectedReason bulkhead.RejectionReason
+	}{
+		{"full", 0, time.Second, bulkhead.RejectedFull},
+		{"max wait exceeded", 10 * time.Millisecond, time.Second, bulkhead.RejectedMaxWaitExceeded},
+		{"canceled", time.Second, 10 * time.Millisecond, bulkhead.RejectedCanceled},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			var rejectedEvent bulkhead.RejectedEvent[any]
+			bh := bulkhead.Builder[any](1).
+				WithMaxWaitTime(tc.maxWaitTime).
+				OnRejected(func(e bulkhead.RejectedEvent[any]) {
+					rejectedEvent = e
+				}).
+				Build()
+			bh.TryAcquirePermit()
+			ctx, cancel := context.WithTimeout(context.Background(), tc.ctxTimeout)
+			defer cancel()
+
+			// When
+			err := failsafe.NewExecutor[any](bh).WithContext(ctx).Run(testutil.RunFn(nil))
+
+			// Then
+			assert.Error(t, err)
+			assert.Equal(t, tc.expectedReason, rejectedEvent.Reason)
+			assert.Equal(t, ctx, rejectedEvent.Context)
+		})
+	}
+}
+
+// Asserts that executions beyond the max queue are rejected with RejectedQueueFull.
+func TestBulkheadMaxQueueExceeded(t *testing.T) {
+	// Given
+	var rejectedEvent bulkhead.RejectedEvent[any]
+	bh := bulkhead.Builder[any](1).
+		WithMaxWaitTime(time.Second).
+		WithMaxQueue(0).
+		OnRejected(func(e bulkhead.RejectedEvent[any]) {
+			rejectedEvent = e
+		}).
+		Build()
+	bh.TryAcquirePermit()
+
+	// When
+	err := failsafe.NewExecutor[any](bh).Run(testutil.RunFn(nil))
+
+	// Then
+	assert.ErrorIs(t, err, bulkhead.ErrFull)
+	assert.Equal(t, bulkhead.RejectedQueueFull, rejectedEvent.Reason)
+}
@@ -0,0 +1,133 @@
+package test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/cachepolicy"
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+)
+
+// mapCache is a cachepolicy.Cache backed by a map, which records the ttls that results were cached with.
+type mapCache[R any] struct {
+	mtx     sync.Mutex
+	results map[string]R
+	ttls    map[string]time.Duration
+}
+
+func newMapCache[R any]() *mapCache[R] {
+	return &mapCache[R]{
+		results: make(map[string]R),
+		ttls:    make(map[string]time.Duration),
+	}
+}
+
+func (c *mapCache[R]) Get(key string) (R, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	result, ok := c.results[key]
+	return result, ok
+}
+
+func (c *mapCache[R]) Set(key string, result R, ttl time.Duration) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.results[key] = result
+	c.ttls[key] = ttl
+}
+
+// Asserts that a successful result is cached and returned for subsequent executions without calling the func.
+func TestCachePolicyWithKey(t *testing.T) {
+	// Given
+	cache := newMapCache[string]()
+	var hits, misses, cached int
+	cp := cachepolicy.Builder[string](cache).
+		WithKey("foo").
+		WithTTL(time.Minute).
+		OnCacheHit(func(e failsafe.ExecutionDoneEvent[string]) {
+			hits++
+			assert.Equal(t, "bar", e.Result)
+		}).
+		OnCacheMiss(func(e failsafe.ExecutionEvent[string]) {
+			misses++
+		}).
+		OnResultCached(func(e failsafe.ExecutionDoneEvent[string]) {
+			cached++
+		}).
+		Build()
+	executor := failsafe.NewExecutor[string](cp)
+	calls := 0
+	fn := func() (string, error) {
+		calls++
+		return "bar", nil
+	}
+
+	// When
+	result1, err1 := executor.Get(fn)
+	result2, err2 := executor.Get(fn)
+
+	// Then
+	assert.Equal(t, "bar", result1)
+	assert.NoError(t, err1)
+	assert.Equal(t, "bar", result2)
+	assert.NoError(t, err2)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 1, hits)
+	assert.Equal(t, 1, misses)
+	assert.Equal(t, 1, cached)
+	assert.Equal(t, time.Minute, cache.ttls["foo"])
+}
+
+// Asserts that failures are not cached by default.
+func TestCachePolicyShouldNotCacheFailures(t *testing.T) {
+	// Given
+	cache := newMapCache[string]()
+	cp := cachepolicy.Builder[string](cache).WithKey("foo").Build()
+	stub, _ := testutil.ErrorNTimesThenReturn[string](testutil.ErrInvalidState, 1, "bar")
+
+	// When
+	_, err1 := failsafe.GetWithExecution(stub, cp)
+	result2, err2 := failsafe.GetWithExecution(stub, cp)
+
+	// Then
+	assert.ErrorIs(t, err1, testutil.ErrInvalidState)
+	assert.Equal(t, "bar", result2)
+	assert.NoError(t, err2)
+	assert.Equal(t, map[string]string{"foo": "bar"}, cache.results)
+}
+
+// Asserts that cache keys are provided by a context value in preference to a key func, and that executions without a
+// key do not use the cache.
+func TestCachePolicyWithKeyFuncAndContextKey(t *testing.T) {
+	// Given
+	type userIDKey struct{}
+	cache := newMapCache[string]()
+	cp := cachepolicy.Builder[string](cache).
+		WithKeyFunc(func(exec failsafe.Execution[string]) string {
+			userID, _ := exec.Context().Value(userIDKey{}).(string)
+			return userID
+		}).
+		Build()
+	executor := failsafe.NewExecutor[string](cp)
+	getWithContext := func(ctx context.Context, result string) string {
+		result, _ = executor.WithContext(ctx).Get(func() (string, error) {
+			return result, nil
+		})
+		return result
+	}
+	user1Ctx := context.WithValue(context.Background(), userIDKey{}, "user1")
+
+	// When / Then
+	assert.Equal(t, "a", getWithContext(user1Ctx, "a"))
+	assert.Equal(t, "a", getWithContext(user1Ctx, "b"))
+	assert.Equal(t, "c", getWithContext(context.WithValue(context.Background(), userIDKey{}, "user2"), "c"))
+	assert.Equal(t, "d", getWithContext(cachepolicy.ContextWithCacheKey(user1Ctx, "override"), "d"))
+	assert.Equal(t, "e", getWithContext(context.Background(), "e"))
+	assert.Equal(t, "f", getWithContext(context.Background(), "f"))
+	assert.Equal(t, map[string]string{"user1": "a", "user2": "c", "override": "d"}, cache.results)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/failsafe-go/failsafe-go"
 	"github.com/failsafe-go/failsafe-go/circuitbreaker"
+	"github.com/failsafe-go/failsafe-go/clock"
 	"github.com/failsafe-go/failsafe-go/internal/policytesting"
 	"github.com/failsafe-go/failsafe-go/internal/testutil"
 	"github.com/failsafe-go/failsafe-go/retrypolicy"
@@ -202,3 +203,342 @@ func TestShouldSupportTimeBasedFailureRateThresholding(t *testing.T) {
 	executor.Get(testutil.GetTrueFn)
 	assert.True(t, cb.IsClosed())
 }
+
+// Asserts that failures expire from a time-based window, so that failures from a low traffic service don't linger, as
+// they do with a count-based window.
+func TestTimeBasedWindowExpiresOldFailures(t *testing.T) {
+	// Given
+	fakeClock := clock.NewFakeClock(time.Now())
+	timeBased := circuitbreaker.Builder[bool]().
+		WithFailureRateThreshold(50, 2, time.Minute).
+		WithClock(fakeClock).
+		Build()
+	countBased := circuitbreaker.Builder[bool]().
+		WithFailureThresholdRatio(2, 4).
+		WithClock(fakeClock).
+		Build()
+
+	for _, cb := range []circuitbreaker.CircuitBreaker[bool]{timeBased, countBased} {
+		// When a failure is followed by successes over a few minutes, and then another failure
+		executor := failsafe.NewExecutor[bool](cb)
+		executor.Get(testutil.GetFn(false, testutil.ErrInvalidState))
+		fakeClock.Advance(2 * time.Minute)
+		executor.Get(testutil.GetFn(true, nil))
+		executor.Get(testutil.GetFn(true, nil))
+		executor.Get(testutil.GetFn(false, testutil.ErrInvalidState))
+	}
+
+	// Then the old failure only counts toward the count-based window
+	assert.True(t, timeBased.IsClosed())
+	assert.Equal(t, uint(1), timeBased.Metrics().Failures())
+	assert.True(t, countBased.IsOpen())
+}
+
+// Asserts that a CircuitBreaker can be used outside an Executor, such as in a message consumer, by acquiring permits and
+// recording results.
+func TestStandaloneCircuitBreaker(t *testing.T) {
+	// Given
+	fakeClock := clock.NewFakeClock(time.Now())
+	cb := circuitbreaker.Builder[bool]().
+		WithFailureThreshold(2).
+		WithDelay(time.Minute).
+		WithClock(fakeClock).
+		Build()
+	consume := func(err error) bool {
+		if !cb.TryAcquirePermit() {
+			return false
+		}
+		cb.RecordError(err)
+		return true
+	}
+
+	// When / Then
+	assert.True(t, consume(nil))
+	assert.True(t, consume(testutil.ErrInvalidState))
+	assert.True(t, cb.TryAcquirePermit())
+	cb.RecordFailure()
+	assert.True(t, cb.IsOpen())
+	assert.False(t, consume(nil))
+
+	// When the delay elapses
+	fakeClock.Advance(time.Minute)
+
+	// Then
+	assert.True(t, cb.TryAcquirePermit())
+	cb.RecordSuccess()
+	assert.True(t, cb.IsClosed())
+}
+
+type traceIDKey struct{}
+
+// Asserts that state changed events provide the context of the execution that caused the state change, so that metrics
+// can be associated with it, such as via a trace ID.
+func TestStateChangedEventContext(t *testing.T) {
+	// Given
+	var traceIDs []any
+	cb := circuitbreaker.Builder[any]().
+		WithDelay(10 * time.Millisecond).
+		OnStateChanged(func(e circuitbreaker.StateChangedEvent) {
+			traceIDs = append(traceIDs, e.Context().Value(traceIDKey{}))
+		}).
+		Build()
+	executor := failsafe.NewExecutor[any](cb)
+
+	// When
+	executor.WithContext(context.WithValue(context.Background(), traceIDKey{}, "trace-1")).Run(testutil.RunFn(testutil.ErrInvalidState))
+	time.Sleep(10 * time.Millisecond)
+	executor.WithContext(context.WithValue(context.Background(), traceIDKey{}, "trace-2")).Run(testutil.RunFn(nil))
+	cb.Open()
+
+	// Then
+	assert.Equal(t, []any{"trace-1", nil, "trace-2", nil}, traceIDs)
+}
+
+// Asserts that the half-open success threshold is applied independently of the closed failure threshold.
+func TestHalfOpenSuccessThresholdIndependentOfFailureThreshold(t *testing.T) {
+	// Given
+	cb := circuitbreaker.Builder[any]().
+		WithFailureThresholdRatio(5, 10).
+		WithSuccessThreshold(3).
+		WithDelay(10 * time.Millisecond).
+		Build()
+	executor := failsafe.NewExecutor[any](cb)
+	run := func(times int, err error) {
+		for i := 0; i < times; i++ {
+			executor.Run(testutil.RunFn(err))
+		}
+	}
+
+	// When / Then
+	run(5, testutil.ErrInvalidState)
+	assert.True(t, cb.IsOpen())
+
+	// When half-open, a failure before the success threshold is met re-opens the circuit
+	time.Sleep(10 * time.Millisecond)
+	run(2, nil)
+	assert.True(t, cb.IsHalfOpen())
+	run(1, testutil.ErrInvalidState)
+	assert.True(t, cb.IsOpen())
+
+	// When half-open, consecutive successes that meet the success threshold close the circuit
+	time.Sleep(10 * time.Millisecond)
+	run(3, nil)
+	assert.True(t, cb.IsClosed())
+
+	// When closed, the failure threshold is used again
+	run(4, testutil.ErrInvalidState)
+	assert.True(t, cb.IsClosed())
+	run(1, testutil.ErrInvalidState)
+	assert.True(t, cb.IsOpen())
+}
+
+// Asserts that probe executions do not affect a CircuitBreaker's state, and are permitted when it's open.
+func TestProbeDoesNotTripCircuitBreaker(t *testing.T) {
+	// Given
+	cb := circuitbreaker.WithDefaults[bool]()
+	executor := failsafe.NewExecutor[bool](cb)
+
+	// When
+	_, err := executor.Probe(testutil.GetFn(false, testutil.ErrInvalidState))
+
+	// Then
+	assert.ErrorIs(t, err, testutil.ErrInvalidState)
+	assert.True(t, cb.IsClosed())
+	assert.Equal(t, uint(0), cb.Metrics().Failures())
+
+	// When a non-probe execution fails
+	_, err = executor.Get(testutil.GetFn(false, testutil.ErrInvalidState))
+
+	// Then
+	assert.ErrorIs(t, err, testutil.ErrInvalidState)
+	assert.True(t, cb.IsOpen())
+
+	// When a probe is performed while open
+	result, err := executor.Probe(testutil.GetFn(true, nil))
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, result)
+	assert.True(t, cb.IsOpen())
+}
+
+func TestNamedCircuitBreakerRegistry(t *testing.T) {
+	// Given
+	names := []string{"registry-a", "registry-b", "registry-c"}
+	breakers := make([]circuitbreaker.CircuitBreaker[any], len(names))
+	for i, name := range names {
+		breakers[i] = circuitbreaker.Builder[any]().WithName(name).Build()
+		defer circuitbreaker.Unregister(name)
+	}
+	unnamed := circuitbreaker.WithDefaults[any]()
+
+	// When
+	breakers[0].Open()
+	breakers[2].Open()
+	unnamed.Open()
+
+	// Then
+	assert.Equal(t, []string{"registry-a", "registry-c"}, circuitbreaker.OpenBreakers())
+	assert.Equal(t, 2, circuitbreaker.OpenCount())
+	states := circuitbreaker.States()
+	assert.Equal(t, circuitbreaker.OpenState, states["registry-a"])
+	assert.Equal(t, circuitbreaker.ClosedState, states["registry-b"])
+	assert.Equal(t, circuitbreaker.OpenState, states["registry-c"])
+
+	// When
+	breakers[0].Close()
+	circuitbreaker.Unregister("registry-c")
+
+	// Then
+	assert.Empty(t, circuitbreaker.OpenBreakers())
+	assert.Equal(t, 0, circuitbreaker.OpenCount())
+}
+
+// Asserts that the OnOpen event contains summaries of the most recent failures that opened the CircuitBreaker.
+func TestRecentFailuresOnOpen(t *testing.T) {
+	// Given
+	var openEvent circuitbreaker.StateChangedEvent
+	cb := circuitbreaker.Builder[string]().
+		WithFailureThreshold(3).
+		WithRecentFailures(2, func(result string, err error) string {
+			return fmt.Sprintf("%s: %v", result, err)
+		}).
+		OnOpen(func(e circuitbreaker.StateChangedEvent) {
+			openEvent = e
+		}).
+		Build()
+	executor := failsafe.NewExecutor[string](cb)
+	start := time.Now()
+
+	// When
+	for i := 1; i <= 3; i++ {
+		executor.Get(func() (string, error) {
+			return fmt.Sprintf("attempt%d", i), testutil.ErrConnecting
+		})
+	}
+
+	// Then
+	assert.True(t, cb.IsOpen())
+	assert.Len(t, openEvent.RecentFailures, 2)
+	assert.Equal(t, "attempt2: connection error", openEvent.RecentFailures[0].Summary)
+	assert.Equal(t, "attempt3: connection error", openEvent.RecentFailures[1].Summary)
+	for _, failure := range openEvent.RecentFailures {
+		assert.False(t, failure.Time.Before(start))
+	}
+
+	// When
+	cb.Close()
+	cb.RecordError(testutil.ErrInvalidState)
+	cb.RecordError(testutil.ErrInvalidState)
+	cb.RecordError(testutil.ErrInvalidArgument)
+
+	// Then
+	assert.True(t, cb.IsOpen())
+	assert.Len(t, openEvent.RecentFailures, 2)
+	assert.Equal(t, ": invalid state", openEvent.RecentFailures[0].Summary)
+	assert.Equal(t, ": invalid argument", openEvent.RecentFailures[1].Summary)
+}
+
+// Tests that a CircuitBreaker with a health func opens based on latency alone, even when executions succeed.
+func TestHealthFuncOpensOnLatency(t *testing.T) {
+	// Given
+	var lastStats circuitbreaker.WindowStats
+	cb := circuitbreaker.Builder[string]().
+		WithFailureThresholdRatio(1, 4).
+		WithHealthFunc(func(stats circuitbreaker.WindowStats) bool {
+			lastStats = stats
+			return stats.LatencyPercentile(99) < 50*time.Millisecond
+		}).
+		Build()
+	executor := failsafe.NewExecutor[string](cb)
+
+	// When
+	for i := 0; i < 3; i++ {
+		executor.Get(func() (string, error) {
+			return "fast", nil
+		})
+	}
+
+	// Then
+	assert.True(t, cb.IsClosed())
+	assert.Equal(t, uint(3), lastStats.Executions)
+	assert.Less(t, lastStats.LatencyPercentile(99), 50*time.Millisecond)
+
+	// When
+	result, err := executor.Get(func() (string, error) {
+		time.Sleep(60 * time.Millisecond)
+		return "slow", nil
+	})
+
+	// Then
+	assert.Equal(t, "slow", result)
+	assert.NoError(t, err)
+	assert.True(t, cb.IsOpen())
+	assert.Equal(t, uint(4), lastStats.Executions)
+	assert.Equal(t, uint(4), lastStats.Successes)
+	assert.Equal(t, uint(0), lastStats.FailureRate)
+	assert.GreaterOrEqual(t, lastStats.LatencyPercentile(99), 60*time.Millisecond)
+	assert.Less(t, lastStats.LatencyPercentile(50), 50*time.Millisecond)
+}
+
+// Tests that an initially open CircuitBreaker rejects executions until its delay elapses and a trial execution succeeds.
+func TestInitiallyOpen(t *testing.T) {
+	// Given
+	cb := circuitbreaker.Builder[string]().
+		WithInitialState(circuitbreaker.OpenState).
+		WithDelay(50 * time.Millisecond).
+		Build()
+	executor := failsafe.NewExecutor[string](cb)
+	fn := func() (string, error) {
+		return "success", nil
+	}
+
+	// When / Then
+	assert.True(t, cb.IsOpen())
+	_, err := executor.Get(fn)
+	assert.ErrorIs(t, err, circuitbreaker.ErrOpen)
+
+	// When
+	time.Sleep(60 * time.Millisecond)
+	result, err := executor.Get(fn)
+
+	// Then
+	assert.Equal(t, "success", result)
+	assert.NoError(t, err)
+	assert.True(t, cb.IsClosed())
+}
+
+// Asserts that a CircuitBreaker opens when the rate of slow calls meets the slow call threshold, even though the calls
+// succeed.
+func TestSlowCallThresholdOpens(t *testing.T) {
+	// Given
+	cb := circuitbreaker.Builder[string]().
+		WithFailureThresholdRatio(1, 4).
+		WithSlowCallThreshold(30*time.Millisecond, 50).
+		Build()
+	executor := failsafe.NewExecutor[string](cb)
+	slowFn := func() (string, error) {
+		time.Sleep(40 * time.Millisecond)
+		return "slow", nil
+	}
+
+	// When
+	executor.Get(func() (string, error) {
+		return "fast", nil
+	})
+	executor.Get(func() (string, error) {
+		return "fast", nil
+	})
+	executor.Get(slowFn)
+
+	// Then
+	assert.True(t, cb.IsClosed())
+
+	// When
+	result, err := executor.Get(slowFn)
+
+	// Then
+	assert.Equal(t, "slow", result)
+	assert.NoError(t, err)
+	assert.True(t, cb.IsOpen())
+}
@@ -0,0 +1,150 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/circuitbreaker"
+	"github.com/failsafe-go/failsafe-go/internal/policytesting"
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+	"github.com/failsafe-go/failsafe-go/retrypolicy"
+)
+
+func classifyTestErrors(err error) failsafe.Classification {
+	if errors.Is(err, testutil.ErrInvalidArgument) {
+		return failsafe.Fatal
+	}
+	if errors.Is(err, testutil.ErrInvalidState) {
+		return failsafe.Ignore
+	}
+	return failsafe.Retryable
+}
+
+// Asserts that an error classified as fatal aborts retries.
+func TestClassifierFatalErrorAbortsRetries(t *testing.T) {
+	// Given
+	failsafe.SetErrorClassifier(classifyTestErrors)
+	defer failsafe.SetErrorClassifier(nil)
+	stats := &policytesting.Stats{}
+	rp := policytesting.WithRetryStatsAndLogs(retrypolicy.Builder[any](), stats).Build()
+
+	// When / Then
+	testutil.TestRunFailure(t, policytesting.SetupFn(stats), failsafe.NewExecutor[any](rp),
+		func(exec failsafe.Execution[any]) error {
+			return testutil.ErrInvalidArgument
+		},
+		1, 1, testutil.ErrInvalidArgument, func() {
+			assert.Equal(t, 1, stats.Aborts())
+			assert.Equal(t, 0, stats.Retries())
+		})
+}
+
+// Asserts that an error classified as retryable is retried.
+func TestClassifierRetryableErrorRetried(t *testing.T) {
+	// Given
+	failsafe.SetErrorClassifier(classifyTestErrors)
+	defer failsafe.SetErrorClassifier(nil)
+	rp := retrypolicy.Builder[any]().ReturnLastFailure().Build()
+
+	// When / Then
+	testutil.TestRunFailure(t, nil, failsafe.NewExecutor[any](rp),
+		func(exec failsafe.Execution[any]) error {
+			return testutil.ErrConnecting
+		},
+		3, 3, testutil.ErrConnecting)
+}
+
+// Asserts that an error classified as ignored is not recorded as a failure by a CircuitBreaker.
+func TestClassifierIgnoredErrorNotRecorded(t *testing.T) {
+	// Given
+	failsafe.SetErrorClassifier(classifyTestErrors)
+	defer failsafe.SetErrorClassifier(nil)
+	cb := circuitbreaker.WithDefaults[any]()
+
+	// When
+	err := failsafe.Run(testutil.RunFn(testutil.ErrInvalidState), cb)
+
+	// Then
+	assert.ErrorIs(t, err, testutil.ErrInvalidState)
+	assert.True(t, cb.IsClosed())
+	assert.Equal(t, uint(1), cb.Metrics().Successes())
+}
+
+// Asserts that policy handle conditions take precedence over a registered classifier.
+func TestClassifierPolicyConditionsTakePrecedence(t *testing.T) {
+	// Given
+	failsafe.SetErrorClassifier(classifyTestErrors)
+	defer failsafe.SetErrorClassifier(nil)
+	rp := retrypolicy.Builder[any]().
+		HandleErrors(testutil.ErrInvalidState).
+		ReturnLastFailure().
+		Build()
+
+	// When / Then
+	testutil.TestRunFailure(t, nil, failsafe.NewExecutor[any](rp),
+		func(exec failsafe.Execution[any]) error {
+			return testutil.ErrInvalidState
+		},
+		3, 3, testutil.ErrInvalidState)
+}
+
+// Asserts that an error classified as fatal aborts retries even when the policy has abort conditions that don't match it.
+func TestClassifierFatalErrorAbortsRetriesWithAbortConditions(t *testing.T) {
+	// Given
+	failsafe.SetErrorClassifier(classifyTestErrors)
+	defer failsafe.SetErrorClassifier(nil)
+	stats := &policytesting.Stats{}
+	rp := policytesting.WithRetryStatsAndLogs(retrypolicy.Builder[any](), stats).
+		HandleErrors(testutil.ErrInvalidArgument).
+		AbortOnErrors(testutil.ErrConnecting).
+		Build()
+
+	// When / Then
+	testutil.TestRunFailure(t, policytesting.SetupFn(stats), failsafe.NewExecutor[any](rp),
+		func(exec failsafe.Execution[any]) error {
+			return testutil.ErrInvalidArgument
+		},
+		1, 1, testutil.ErrInvalidArgument, func() {
+			assert.Equal(t, 1, stats.Aborts())
+			assert.Equal(t, 0, stats.Retries())
+		})
+}
+
+// Asserts that errors can be handled by their messages via failsafe.ErrorMessageMatcher.
+func TestErrorMessageMatcherHandlesErrors(t *testing.T) {
+	// Given
+	rp := retrypolicy.Builder[any]().
+		HandleIf(failsafe.ErrorMessageMatcher[any]("connection reset")).
+		ReturnLastFailure().
+		Build()
+	executor := failsafe.NewExecutor[any](rp)
+
+	t.Run("with matching message", func(t *testing.T) {
+		// When
+		attempts := 0
+		err := executor.Run(func() error {
+			attempts++
+			return errors.New("read: connection reset by peer")
+		})
+
+		// Then
+		assert.Error(t, err)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("with non-matching message", func(t *testing.T) {
+		// When
+		attempts := 0
+		err := executor.Run(func() error {
+			attempts++
+			return errors.New("invalid argument")
+		})
+
+		// Then
+		assert.Error(t, err)
+		assert.Equal(t, 1, attempts)
+	})
+}
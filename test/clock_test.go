@@ -0,0 +1,166 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/circuitbreaker"
+	"github.com/failsafe-go/failsafe-go/clock"
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+	"github.com/failsafe-go/failsafe-go/ratelimiter"
+	"github.com/failsafe-go/failsafe-go/retrypolicy"
+	"github.com/failsafe-go/failsafe-go/timeout"
+)
+
+// Asserts that RetryPolicy delays are waited for with a configured clock.
+func TestRetryPolicyWithFakeClock(t *testing.T) {
+	// Given
+	fakeClock := clock.NewFakeClock(time.Now())
+	rp := retrypolicy.Builder[any]().
+		WithDelay(time.Hour).
+		WithClock(fakeClock).
+		Build()
+	done := make(chan error)
+
+	// When
+	go func() {
+		done <- failsafe.NewExecutor[any](rp).Run(testutil.RunFn(testutil.ErrInvalidState))
+	}()
+	for i := 0; i < 2; i++ {
+		fakeClock.BlockUntilTimers(1)
+		fakeClock.Advance(time.Hour)
+	}
+
+	// Then
+	assert.ErrorIs(t, <-done, retrypolicy.ErrExceeded)
+}
+
+// Asserts that a delay until a next retry time is measured with a RetryPolicy's configured clock.
+func TestRetryPolicyNextRetryTimeWithFakeClock(t *testing.T) {
+	// Given
+	fakeClock := clock.NewFakeClock(time.Now().Add(-24 * time.Hour))
+	delays := make(chan time.Duration, 1)
+	rp := retrypolicy.Builder[any]().
+		WithClock(fakeClock).
+		OnRetryScheduled(func(e failsafe.ExecutionScheduledEvent[any]) {
+			delays <- e.Delay
+		}).
+		Build()
+	done := make(chan error)
+
+	// When
+	go func() {
+		done <- failsafe.NewExecutor[any](rp).RunWithExecution(func(exec failsafe.Execution[any]) error {
+			if exec.IsFirstAttempt() {
+				exec.SetNextRetryTime(fakeClock.Now().Add(time.Hour))
+				return testutil.ErrInvalidState
+			}
+			return nil
+		})
+	}()
+	fakeClock.BlockUntilTimers(1)
+	fakeClock.Advance(time.Hour)
+
+	// Then
+	assert.NoError(t, <-done)
+	assert.Equal(t, time.Hour, <-delays)
+}
+
+// Asserts that a RetryPolicy's max duration is measured with its configured clock.
+func TestRetryPolicyMaxDurationWithFakeClock(t *testing.T) {
+	// Given
+	fakeClock := clock.NewFakeClock(time.Now())
+	delays := make(chan time.Duration, 2)
+	rp := retrypolicy.Builder[any]().
+		WithMaxRetries(-1).
+		WithDelay(time.Hour).
+		WithMaxDuration(90 * time.Minute).
+		WithClock(fakeClock).
+		OnRetryScheduled(func(e failsafe.ExecutionScheduledEvent[any]) {
+			delays <- e.Delay
+		}).
+		Build()
+	done := make(chan failsafe.ExecutionDoneEvent[any])
+
+	// When
+	go func() {
+		done <- failsafe.NewExecutor[any](rp).RunWithResult(testutil.RunFn(testutil.ErrInvalidState))
+	}()
+	fakeClock.BlockUntilTimers(1)
+	fakeClock.Advance(time.Hour)
+	fakeClock.BlockUntilTimers(1)
+	fakeClock.Advance(30 * time.Minute)
+
+	// Then
+	event := <-done
+	assert.ErrorIs(t, event.Error, retrypolicy.ErrExceeded)
+	assert.Equal(t, 3, event.Attempts())
+	assert.Equal(t, time.Hour, <-delays)
+	assert.InDelta(t, 30*time.Minute, <-delays, float64(time.Second))
+}
+
+// Asserts that a Timeout is exceeded when a configured clock is advanced past the time limit.
+func TestTimeoutWithFakeClock(t *testing.T) {
+	// Given
+	fakeClock := clock.NewFakeClock(time.Now())
+	to := timeout.Builder[any](time.Hour).WithClock(fakeClock).Build()
+	done := make(chan error)
+
+	// When
+	go func() {
+		done <- failsafe.NewExecutor[any](to).RunWithExecution(func(exec failsafe.Execution[any]) error {
+			<-exec.Canceled()
+			return nil
+		})
+	}()
+	fakeClock.BlockUntilTimers(1)
+	fakeClock.Advance(time.Hour)
+
+	// Then
+	assert.ErrorIs(t, <-done, timeout.ErrExceeded)
+}
+
+// Asserts that a CircuitBreaker's delay is measured with a configured clock.
+func TestCircuitBreakerWithFakeClock(t *testing.T) {
+	// Given
+	fakeClock := clock.NewFakeClock(time.Now())
+	cb := circuitbreaker.Builder[any]().
+		WithDelay(time.Hour).
+		WithClock(fakeClock).
+		Build()
+	cb.Open()
+
+	// When / Then
+	assert.False(t, cb.TryAcquirePermit())
+	assert.Equal(t, time.Hour, cb.RemainingDelay())
+	fakeClock.Advance(time.Hour)
+	assert.Equal(t, time.Duration(0), cb.RemainingDelay())
+	assert.True(t, cb.TryAcquirePermit())
+	assert.True(t, cb.IsHalfOpen())
+}
+
+// Asserts that a RateLimiter's permits are waited for with a configured clock.
+func TestRateLimiterWithFakeClock(t *testing.T) {
+	// Given
+	fakeClock := clock.NewFakeClock(time.Now())
+	rl := ratelimiter.SmoothBuilderWithMaxRate[any](time.Hour).
+		WithMaxWaitTime(2 * time.Hour).
+		WithClock(fakeClock).
+		Build()
+	assert.True(t, rl.TryAcquirePermit())
+	done := make(chan error)
+
+	// When
+	go func() {
+		done <- failsafe.NewExecutor[any](rl).Run(testutil.RunFn(nil))
+	}()
+	fakeClock.BlockUntilTimers(1)
+	fakeClock.Advance(time.Hour)
+
+	// Then
+	assert.NoError(t, <-done)
+	assert.False(t, rl.TryAcquirePermit())
+}
@@ -0,0 +1,77 @@
+package test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/coalesce"
+)
+
+// Asserts that concurrent executions with the same key share a single execution's result.
+func TestCoalesceSharesResult(t *testing.T) {
+	// Given
+	var coalesced atomic.Int32
+	c := coalesce.Builder[string]().
+		WithKeyFunc(userFromContext[string]).
+		OnCoalesced(func(failsafe.ExecutionEvent[string]) {
+			coalesced.Add(1)
+		}).
+		Build()
+	executions := map[string]*atomic.Int32{"a": {}, "b": {}}
+	var wg sync.WaitGroup
+	started := make(chan struct{})
+
+	// When
+	for _, user := range []string{"a", "a", "a", "b", "b", "b"} {
+		user := user
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx := context.WithValue(context.Background(), userKey{}, user)
+			result, err := failsafe.NewExecutor[string](c).WithContext(ctx).Get(func() (string, error) {
+				executions[user].Add(1)
+				<-started
+				return user, nil
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, user, result)
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(started)
+	wg.Wait()
+
+	// Then
+	assert.Equal(t, int32(1), executions["a"].Load())
+	assert.Equal(t, int32(1), executions["b"].Load())
+	assert.Equal(t, int32(4), coalesced.Load())
+}
+
+// Asserts that an execution waiting for a shared result is canceled when its context is canceled.
+func TestCoalesceCancelWhileWaiting(t *testing.T) {
+	// Given
+	c := coalesce.Builder[any]().Build()
+	release := make(chan struct{})
+	defer close(release)
+	go failsafe.NewExecutor[any](c).Run(func() error {
+		<-release
+		return nil
+	})
+	time.Sleep(20 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	// When
+	err := failsafe.NewExecutor[any](c).WithContext(ctx).Run(func() error {
+		return nil
+	})
+
+	// Then
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
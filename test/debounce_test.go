@@ -0,0 +1,72 @@
+package test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/debounce"
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+)
+
+// Asserts that executions within the cooldown are coalesced into the previous execution.
+func TestDebounceDrop(t *testing.T) {
+	// Given
+	db := debounce.WithCooldown[int](100 * time.Millisecond)
+	executor := failsafe.NewExecutor[int](db)
+	var executions atomic.Int32
+	fn := func() (int, error) {
+		time.Sleep(20 * time.Millisecond)
+		return int(executions.Add(1)), nil
+	}
+
+	// When
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := executor.Get(fn)
+
+			// Then
+			assert.NoError(t, err)
+			assert.Equal(t, 1, result)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), executions.Load())
+
+	// When the cooldown has elapsed
+	time.Sleep(100 * time.Millisecond)
+	result, err := executor.Get(fn)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result)
+}
+
+// Asserts that executions within the cooldown are delayed until the end of the cooldown.
+func TestDebounceDelay(t *testing.T) {
+	// Given
+	db := debounce.BuilderWithCooldown[any](50 * time.Millisecond).WithMode(debounce.Delay).Build()
+	executor := failsafe.NewExecutor[any](db)
+	var executions atomic.Int32
+
+	// When
+	elapsed := testutil.Timed(func() {
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, executor.Run(func() error {
+				executions.Add(1)
+				return nil
+			}))
+		}
+	})
+
+	// Then
+	assert.Equal(t, int32(3), executions.Load())
+	assert.GreaterOrEqual(t, elapsed, 100*time.Millisecond)
+}
@@ -2,6 +2,7 @@ package test
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -66,3 +67,42 @@ func TestShouldDelayCircuitBreaker(t *testing.T) {
 	executor.Get(testutil.GetFn[int](1, nil))
 	assert.Equal(t, 1, delays)
 }
+
+type retryAfterError struct {
+	retryAfter time.Duration
+}
+
+func (e *retryAfterError) Error() string {
+	return "retry after " + e.retryAfter.String()
+}
+
+func (e *retryAfterError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+// Asserts that ErrorDelayFunc delays according to a DelayableError, and falls back to the configured delay otherwise.
+func TestRetryPolicyWithErrorDelayFunc(t *testing.T) {
+	// Given
+	var delays []time.Duration
+	rp := retrypolicy.Builder[any]().
+		WithMaxRetries(2).
+		WithDelay(time.Millisecond).
+		WithDelayFunc(failsafe.ErrorDelayFunc[any]()).
+		OnRetryScheduled(func(e failsafe.ExecutionScheduledEvent[any]) {
+			delays = append(delays, e.Delay)
+		}).
+		Build()
+	errs := []error{fmt.Errorf("wrapped: %w", &retryAfterError{20 * time.Millisecond}), testutil.ErrInvalidState}
+
+	// When
+	err := failsafe.NewExecutor[any](rp).RunWithExecution(func(exec failsafe.Execution[any]) error {
+		if exec.Attempts() <= len(errs) {
+			return errs[exec.Attempts()-1]
+		}
+		return nil
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Duration{20 * time.Millisecond, time.Millisecond}, delays)
+}
@@ -0,0 +1,82 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/exactlyonce"
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+	"github.com/failsafe-go/failsafe-go/retrypolicy"
+)
+
+type orderKey struct{}
+
+func orderFromContext[R any](exec failsafe.Execution[R]) string {
+	return exec.Context().Value(orderKey{}).(string)
+}
+
+// Asserts that a second execution with the same key skips the fn and returns the stored result.
+func TestExactlyOnceSkipsDoneKey(t *testing.T) {
+	// Given
+	store := exactlyonce.NewMemoryStore[string]()
+	var duplicates []string
+	eo := exactlyonce.Builder[string](store).
+		WithKeyFunc(orderFromContext[string]).
+		OnDuplicate(func(e exactlyonce.DuplicateEvent[string]) {
+			duplicates = append(duplicates, e.Key)
+		}).
+		Build()
+	executions := 0
+	execute := func(order string) (string, error) {
+		ctx := context.WithValue(context.Background(), orderKey{}, order)
+		return failsafe.NewExecutor[string](eo).WithContext(ctx).Get(func() (string, error) {
+			executions++
+			return "charged " + order, nil
+		})
+	}
+
+	// When
+	result1, err1 := execute("order-1")
+	result2, err2 := execute("order-1")
+	result3, err3 := execute("order-2")
+
+	// Then
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.NoError(t, err3)
+	assert.Equal(t, "charged order-1", result1)
+	assert.Equal(t, "charged order-1", result2)
+	assert.Equal(t, "charged order-2", result3)
+	assert.Equal(t, 2, executions)
+	assert.Equal(t, []string{"order-1"}, duplicates)
+}
+
+// Asserts that a key is only marked done after a successful execution, so that failed executions are retried.
+func TestExactlyOnceMarksDoneAfterSuccess(t *testing.T) {
+	// Given
+	store := exactlyonce.NewMemoryStore[string]()
+	eo := exactlyonce.With[string](store, orderFromContext[string])
+	rp := retrypolicy.WithDefaults[string]()
+	ctx := context.WithValue(context.Background(), orderKey{}, "order-1")
+	executions := 0
+
+	// When
+	result, err := failsafe.NewExecutor[string](rp, eo).WithContext(ctx).Get(func() (string, error) {
+		executions++
+		if executions == 1 {
+			return "", testutil.ErrInvalidState
+		}
+		return "charged", nil
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "charged", result)
+	assert.Equal(t, 2, executions)
+	storedResult, done := store.IsDone("order-1")
+	assert.True(t, done)
+	assert.Equal(t, "charged", storedResult)
+}
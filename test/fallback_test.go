@@ -1,12 +1,17 @@
 package test
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 
 	"github.com/failsafe-go/failsafe-go"
 	"github.com/failsafe-go/failsafe-go/fallback"
 	"github.com/failsafe-go/failsafe-go/internal/testutil"
+	"github.com/failsafe-go/failsafe-go/timeout"
 )
 
 // Tests Fallback.WithResult
@@ -90,3 +95,206 @@ func TestShouldVerifyFallbackResult(t *testing.T) {
 			return false, errors.New("test")
 		}, 1, 1, true)
 }
+
+type fallbackKey struct{}
+
+// Asserts that a Fallback with a detached context can perform its own calls after the execution's context times out.
+func TestFallbackWithDetachedContext(t *testing.T) {
+	// Given
+	downstreamCall := func(ctx context.Context) (string, error) {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+			return ctx.Value(fallbackKey{}).(string), nil
+		}
+	}
+	fb := fallback.BuilderWithFunc(func(exec failsafe.Execution[string]) (string, error) {
+		return downstreamCall(exec.Context())
+	}).WithDetachedContext(time.Second).Build()
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), fallbackKey{}, "fallback"), 50*time.Millisecond)
+	defer cancel()
+
+	// When
+	result, err := failsafe.NewExecutor[string](fb).WithContext(ctx).GetWithExecution(func(exec failsafe.Execution[string]) (string, error) {
+		<-exec.Canceled()
+		return "", exec.Context().Err()
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "fallback", result)
+}
+
+// Asserts that tiered fallback funcs are called in order until one succeeds.
+func TestTieredFallbacks(t *testing.T) {
+	// Given
+	var lastErrors []error
+	fb := fallback.WithFuncs[string](
+		func(exec failsafe.Execution[string]) (string, error) {
+			lastErrors = append(lastErrors, exec.LastError())
+			return "", testutil.ErrConnecting
+		},
+		func(exec failsafe.Execution[string]) (string, error) {
+			lastErrors = append(lastErrors, exec.LastError())
+			return "cached", nil
+		},
+		func(exec failsafe.Execution[string]) (string, error) {
+			assert.Fail(t, "the third fallback should not be called")
+			return "", nil
+		})
+
+	// When
+	result, err := failsafe.Get(func() (string, error) {
+		return "", testutil.ErrInvalidState
+	}, fb)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "cached", result)
+	assert.Equal(t, []error{testutil.ErrInvalidState, testutil.ErrConnecting}, lastErrors)
+}
+
+// Asserts that a single Fallback built from an ordered chain of funcs emits events for each tier that's tried, without
+// the nesting of multiple Fallbacks.
+func TestTieredFallbacksWithBuilder(t *testing.T) {
+	// Given
+	var fallbackErrors []error
+	var calls []string
+	fb := fallback.BuilderWithFuncs[string](
+		func(exec failsafe.Execution[string]) (string, error) {
+			calls = append(calls, "secondary")
+			return "", testutil.ErrConnecting
+		},
+		func(exec failsafe.Execution[string]) (string, error) {
+			calls = append(calls, "cache")
+			return "cached", nil
+		},
+		func(exec failsafe.Execution[string]) (string, error) {
+			calls = append(calls, "default")
+			return "default", nil
+		}).
+		OnFallbackExecuted(func(e failsafe.ExecutionDoneEvent[string]) {
+			fallbackErrors = append(fallbackErrors, e.Error)
+		}).
+		Build()
+	successes := 0
+	executor := failsafe.NewExecutor[string](fb).OnSuccess(func(e failsafe.ExecutionDoneEvent[string]) {
+		successes++
+	})
+
+	// When
+	result, err := executor.Get(func() (string, error) {
+		return "", testutil.ErrInvalidState
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, "cached", result)
+	assert.Equal(t, []string{"secondary", "cache"}, calls)
+	assert.Equal(t, []error{testutil.ErrConnecting, nil}, fallbackErrors)
+	assert.Equal(t, 1, successes)
+}
+
+// Asserts that the errors from the execution and each tiered fallback func are joined when they all fail.
+func TestTieredFallbacksJoinErrors(t *testing.T) {
+	// Given
+	err1 := errors.New("secondary unavailable")
+	err2 := errors.New("cache miss")
+	fb := fallback.WithFuncs[string](
+		func(exec failsafe.Execution[string]) (string, error) {
+			return "", err1
+		},
+		func(exec failsafe.Execution[string]) (string, error) {
+			return "", err2
+		})
+
+	// When
+	_, err := failsafe.Get(func() (string, error) {
+		return "", testutil.ErrInvalidState
+	}, fb)
+
+	// Then
+	assert.ErrorIs(t, err, testutil.ErrInvalidState)
+	assert.ErrorIs(t, err, err1)
+	assert.ErrorIs(t, err, err2)
+	assert.Len(t, err.(interface{ Unwrap() []error }).Unwrap(), 3)
+	assert.Contains(t, err.Error(), testutil.ErrInvalidState.Error())
+	assert.Contains(t, err.Error(), "secondary unavailable")
+	assert.Contains(t, err.Error(), "cache miss")
+}
+
+// Asserts that failures are routed to the fallback func for the first matching handler, and that failures which match no
+// handler are returned as is.
+func TestFallbackWithHandlerFor(t *testing.T) {
+	// Given
+	errNotFound := errors.New("not found")
+	errUnauthorized := errors.New("unauthorized")
+	errorIs := func(target error) func(string, error) bool {
+		return func(_ string, err error) bool {
+			return errors.Is(err, target)
+		}
+	}
+	fb := fallback.BuilderWithHandlerFor[string](errorIs(timeout.ErrExceeded), func(exec failsafe.Execution[string]) (string, error) {
+		return "cached", nil
+	}).
+		WithHandlerFor(errorIs(errNotFound), func(exec failsafe.Execution[string]) (string, error) {
+			return "default", nil
+		}).
+		WithHandlerFor(errorIs(errNotFound), func(exec failsafe.Execution[string]) (string, error) {
+			assert.Fail(t, "only the first matching handler should be called")
+			return "", nil
+		}).
+		Build()
+	executor := failsafe.NewExecutor[string](fb, timeout.With[string](10*time.Millisecond))
+
+	// When / Then
+	result, err := executor.Get(func() (string, error) {
+		time.Sleep(50 * time.Millisecond)
+		return "slow", nil
+	})
+	assert.Equal(t, "cached", result)
+	assert.NoError(t, err)
+
+	// When / Then
+	result, err = executor.Get(func() (string, error) {
+		return "", errNotFound
+	})
+	assert.Equal(t, "default", result)
+	assert.NoError(t, err)
+
+	// When / Then
+	result, err = executor.Get(func() (string, error) {
+		return "", errUnauthorized
+	})
+	assert.Equal(t, "", result)
+	assert.ErrorIs(t, err, errUnauthorized)
+}
+
+// Asserts that a Fallback with last known good returns the most recent successful result for an execution's key.
+func TestFallbackWithLastKnownGood(t *testing.T) {
+	// Given
+	fb := fallback.BuilderWithLastKnownGood[string](userFromContext[string], 0).Build()
+	executor := failsafe.NewExecutor[string](fb)
+	ctxA := context.WithValue(context.Background(), userKey{}, "a")
+	ctxB := context.WithValue(context.Background(), userKey{}, "b")
+
+	// When / Then
+	result, err := executor.WithContext(ctxA).Get(func() (string, error) {
+		return "price-a", nil
+	})
+	assert.Equal(t, "price-a", result)
+	assert.NoError(t, err)
+	result, err = executor.WithContext(ctxA).Get(func() (string, error) {
+		return "", testutil.ErrConnecting
+	})
+	assert.Equal(t, "price-a", result)
+	assert.NoError(t, err)
+
+	// No result is known for b
+	result, err = executor.WithContext(ctxB).Get(func() (string, error) {
+		return "", testutil.ErrConnecting
+	})
+	assert.Equal(t, "", result)
+	assert.ErrorIs(t, err, testutil.ErrConnecting)
+}
@@ -0,0 +1,120 @@
+package test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/faultinjection"
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+	"github.com/failsafe-go/failsafe-go/timeout"
+)
+
+// Asserts that a FaultInjection injects failures at the configured rate, and only while enabled.
+func TestFaultInjectionFailureRate(t *testing.T) {
+	// Given
+	var injected atomic.Int32
+	fi := faultinjection.Builder[any]().
+		WithFailureRate(.3).
+		OnFaultInjected(func(e failsafe.ExecutionEvent[any]) {
+			injected.Add(1)
+		}).
+		Build()
+	executor := failsafe.NewExecutor[any](fi)
+	var executions int
+	failedFraction := func() float64 {
+		failed := 0
+		for i := 0; i < 10000; i++ {
+			if err := executor.Run(func() error {
+				executions++
+				return nil
+			}); err != nil {
+				assert.ErrorIs(t, err, faultinjection.ErrInjected)
+				failed++
+			}
+		}
+		return float64(failed) / 10000
+	}
+
+	// When / Then
+	fraction := failedFraction()
+	assert.InDelta(t, .3, fraction, .03)
+	assert.Equal(t, 10000-executions, int(injected.Load()))
+
+	// When disabled
+	fi.Disable()
+
+	// Then
+	assert.False(t, fi.IsEnabled())
+	assert.Equal(t, float64(0), failedFraction())
+
+	// When re-enabled
+	fi.Enable()
+
+	// Then
+	assert.InDelta(t, .3, failedFraction(), .03)
+}
+
+// Asserts that a FaultInjection injects a configured error and latency.
+func TestFaultInjectionErrorAndLatency(t *testing.T) {
+	// Given
+	customErr := errors.New("test")
+	fi := faultinjection.Builder[any]().
+		WithFailureRate(1).
+		WithError(customErr).
+		WithLatency(50*time.Millisecond, 1).
+		Build()
+
+	// When
+	var err error
+	elapsed := testutil.Timed(func() {
+		err = failsafe.NewExecutor[any](fi).Run(func() error {
+			return nil
+		})
+	})
+
+	// Then
+	assert.ErrorIs(t, err, customErr)
+	assert.GreaterOrEqual(t, elapsed, 50*time.Millisecond)
+}
+
+// Asserts that a FaultInjection injects latency within a range.
+func TestFaultInjectionLatencyRange(t *testing.T) {
+	// Given
+	fi := faultinjection.Builder[any]().
+		WithLatencyRange(20*time.Millisecond, 40*time.Millisecond, 1).
+		Build()
+
+	// When
+	elapsed := testutil.Timed(func() {
+		failsafe.NewExecutor[any](fi).Run(func() error {
+			return nil
+		})
+	})
+
+	// Then
+	assert.GreaterOrEqual(t, elapsed, 20*time.Millisecond)
+	assert.Less(t, elapsed, 100*time.Millisecond)
+}
+
+// Asserts that a FaultInjection injects hangs that are ended by an outer Timeout.
+func TestFaultInjectionHangWithTimeout(t *testing.T) {
+	// Given
+	fi := faultinjection.Builder[any]().WithHangRate(1).Build()
+	to := timeout.With[any](50 * time.Millisecond)
+	var executed bool
+
+	// When
+	err := failsafe.NewExecutor[any](to, fi).Run(func() error {
+		executed = true
+		return nil
+	})
+
+	// Then
+	assert.ErrorIs(t, err, timeout.ErrExceeded)
+	assert.False(t, executed)
+}
@@ -0,0 +1,89 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/fallback"
+	"github.com/failsafe-go/failsafe-go/guard"
+	"github.com/failsafe-go/failsafe-go/internal/testutil"
+	"github.com/failsafe-go/failsafe-go/retrypolicy"
+)
+
+var errTooLarge = errors.New("result too large")
+
+func maxLengthValidator(maxLength int) func(string) error {
+	return func(result string) error {
+		if len(result) > maxLength {
+			return errTooLarge
+		}
+		return nil
+	}
+}
+
+// Asserts that an invalid result is retried and then handled by a fallback.
+func TestGuardInvalidResultRetriedThenFallback(t *testing.T) {
+	// Given
+	invalidResults := 0
+	g := guard.BuilderWithResultValidator(maxLengthValidator(5)).
+		OnInvalidResult(func(e failsafe.ExecutionEvent[string]) {
+			invalidResults++
+			assert.Equal(t, "too large", e.LastResult())
+			assert.ErrorIs(t, e.LastError(), guard.ErrInvalidResult)
+			assert.ErrorIs(t, e.LastError(), errTooLarge)
+		}).Build()
+	rp := retrypolicy.WithDefaults[string]()
+	fb := fallback.WithResult("small")
+	setup := func() context.Context {
+		invalidResults = 0
+		return nil
+	}
+
+	// When / Then
+	testutil.TestGetSuccess(t, setup, failsafe.NewExecutor[string](fb, rp, g),
+		func(exec failsafe.Execution[string]) (string, error) {
+			return "too large", nil
+		},
+		3, 3, "small", func() {
+			assert.Equal(t, 3, invalidResults)
+		})
+}
+
+// Asserts that a result that becomes valid after a retry is returned.
+func TestGuardInvalidResultThenValid(t *testing.T) {
+	// Given
+	g := guard.WithResultValidator(maxLengthValidator(5))
+	rp := retrypolicy.WithDefaults[string]()
+	stub, reset := testutil.ErrorNTimesThenReturn[string](nil, 0, "too large", "ok")
+	setup := func() context.Context {
+		reset()
+		return nil
+	}
+
+	// When / Then
+	testutil.TestGetSuccess(t, setup, failsafe.NewExecutor[string](rp, g),
+		stub,
+		2, 2, "ok")
+}
+
+// Asserts that results with errors are not validated.
+func TestGuardDoesNotValidateErrors(t *testing.T) {
+	// Given
+	g := guard.WithResultValidator(func(result string) error {
+		assert.Fail(t, "validator should not be called")
+		return nil
+	})
+
+	// When
+	_, err := failsafe.Get(func() (string, error) {
+		return "", testutil.ErrInvalidState
+	}, g)
+
+	// Then
+	assert.ErrorIs(t, err, testutil.ErrInvalidState)
+	assert.NotErrorIs(t, err, guard.ErrInvalidResult)
+}
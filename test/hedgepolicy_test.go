@@ -1,6 +1,8 @@
 package test
 
 import (
+	"context"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -10,6 +12,8 @@ import (
 	"github.com/failsafe-go/failsafe-go/hedgepolicy"
 	"github.com/failsafe-go/failsafe-go/internal/policytesting"
 	"github.com/failsafe-go/failsafe-go/internal/testutil"
+	"github.com/failsafe-go/failsafe-go/retrypolicy"
+	"github.com/failsafe-go/failsafe-go/timeout"
 )
 
 func TestShouldNotHedgeWhenDelayNotExceeded(t *testing.T) {
@@ -130,3 +134,181 @@ func TestCancelOnResult(t *testing.T) {
 			})
 	})
 }
+
+// Asserts that hedges are launched progressively at the hedge interval after the initial delay.
+func TestHedgeInterval(t *testing.T) {
+	// Given
+	stats := &policytesting.Stats{}
+	hp := policytesting.WithHedgeStatsAndLogs(hedgepolicy.BuilderWithDelay[int](50*time.Millisecond).
+		WithHedgeInterval(100*time.Millisecond).
+		WithMaxHedges(3), stats).Build()
+	var startTime time.Time
+	var launchTimes [4]atomic.Int64
+	var allLaunched chan struct{}
+	setup := func() context.Context {
+		stats.Reset()
+		startTime = time.Now()
+		allLaunched = make(chan struct{})
+		return nil
+	}
+
+	// When / Then
+	testutil.TestGetSuccess(t, setup, failsafe.NewExecutor[int](hp),
+		func(exec failsafe.Execution[int]) (int, error) {
+			attempt := exec.Attempts()
+			launchTimes[attempt-1].Store(int64(time.Since(startTime)))
+			if attempt == 1 {
+				<-allLaunched
+				return attempt, nil
+			}
+			if attempt == 4 {
+				close(allLaunched)
+			}
+			testutil.WaitAndAssertCanceled(t, time.Second, exec)
+			return attempt, nil
+		},
+		4, -1, 1, func() {
+			assert.Equal(t, 3, stats.Hedges())
+			// Hedges are never launched early, but may be launched late on a busy machine, so the upper bound is loose
+			for i, expected := range []time.Duration{50, 150, 250} {
+				launchTime := time.Duration(launchTimes[i+1].Load())
+				assert.GreaterOrEqual(t, launchTime, expected*time.Millisecond)
+				assert.Less(t, launchTime, (expected+200)*time.Millisecond)
+			}
+		})
+}
+
+// Asserts that hedge metrics record whether the primary attempt or a hedge won.
+func TestHedgeMetrics(t *testing.T) {
+	// Given
+	hp := hedgepolicy.BuilderWithDelay[bool](20 * time.Millisecond).WithMaxHedges(2).Build()
+	executor := failsafe.NewExecutor[bool](hp)
+	slowPrimary := func(exec failsafe.Execution[bool]) (bool, error) {
+		if !exec.IsHedge() {
+			select {
+			case <-time.After(time.Second):
+			case <-exec.Canceled():
+			}
+		}
+		return true, nil
+	}
+	fastPrimary := func(exec failsafe.Execution[bool]) (bool, error) {
+		return true, nil
+	}
+
+	// When
+	for i := 0; i < 2; i++ {
+		_, err := executor.GetWithExecution(slowPrimary)
+		assert.NoError(t, err)
+	}
+	for i := 0; i < 3; i++ {
+		_, err := executor.GetWithExecution(fastPrimary)
+		assert.NoError(t, err)
+	}
+
+	// Then
+	metrics := hp.Metrics()
+	assert.Equal(t, uint(3), metrics.PrimaryWins)
+	assert.Equal(t, []uint{2, 0}, metrics.HedgeWins)
+	assert.Equal(t, uint(2), metrics.HedgesFired)
+}
+
+// Asserts that no hedges are performed for an execution that is not idempotent, even after the delay is exceeded.
+func TestShouldNotHedgeNonIdempotentExecution(t *testing.T) {
+	// Given
+	stats := &policytesting.Stats{}
+	hp := policytesting.WithHedgeStatsAndLogs(hedgepolicy.BuilderWithDelay[bool](10*time.Millisecond).WithMaxHedges(2), stats).Build()
+
+	// When / Then
+	testutil.TestGetSuccess(t, policytesting.SetupFn(stats), failsafe.NewExecutor[bool](hp),
+		func(exec failsafe.Execution[bool]) (bool, error) {
+			exec.SetIdempotent(false)
+			time.Sleep(100 * time.Millisecond)
+			return true, nil
+		},
+		1, 1, true, func() {
+			assert.Equal(t, 0, stats.Hedges())
+		})
+}
+
+// Asserts that the result of a non-idempotent execution is returned even when it's not cancellable.
+func TestShouldReturnNonCancellableResultForNonIdempotentExecution(t *testing.T) {
+	// Given
+	hp := hedgepolicy.BuilderWithDelay[bool](10 * time.Millisecond).
+		CancelIf(func(bool, error) bool {
+			return false
+		}).
+		Build()
+
+	// When
+	result, err := failsafe.NewExecutor[bool](hp).GetWithExecution(func(exec failsafe.Execution[bool]) (bool, error) {
+		exec.SetIdempotent(false)
+		time.Sleep(50 * time.Millisecond)
+		return false, testutil.ErrInvalidState
+	})
+
+	// Then
+	assert.False(t, result)
+	assert.ErrorIs(t, err, testutil.ErrInvalidState)
+}
+
+// Asserts that losing attempts are canceled after the grace period once the winning result is returned.
+func TestHedgeLoserGracePeriod(t *testing.T) {
+	// Given
+	hp := hedgepolicy.BuilderWithDelay[int](10 * time.Millisecond).
+		WithLoserGracePeriod(100 * time.Millisecond).
+		Build()
+	loserCanceled := make(chan time.Time, 1)
+
+	// When
+	result, err := failsafe.NewExecutor[int](hp).GetWithExecution(func(exec failsafe.Execution[int]) (int, error) {
+		if exec.Attempts() == 1 {
+			// The primary attempt loses
+			<-exec.Canceled()
+			loserCanceled <- time.Now()
+			return 1, nil
+		}
+		return 2, nil
+	})
+	returnedTime := time.Now()
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result)
+	select {
+	case canceledTime := <-loserCanceled:
+		assert.GreaterOrEqual(t, canceledTime.Sub(returnedTime), 80*time.Millisecond)
+	case <-time.After(time.Second):
+		assert.Fail(t, "expected the losing attempt to be canceled")
+	}
+}
+
+// Asserts that a HedgePolicy composes with a RetryPolicy and Timeout, where attempts that exceed the timeout are retried,
+// and the first successful hedge is returned while the outstanding attempt is canceled.
+func TestHedgeWithRetryAndTimeout(t *testing.T) {
+	// Given
+	rp := retrypolicy.Builder[bool]().WithMaxRetries(2).Build()
+	to := timeout.With[bool](100 * time.Millisecond)
+	hp := hedgepolicy.BuilderWithDelay[bool](10 * time.Millisecond).Build()
+	var primaryCanceled atomic.Bool
+
+	// When
+	result, err := failsafe.NewExecutor[bool](rp, to, hp).GetWithExecution(func(exec failsafe.Execution[bool]) (bool, error) {
+		if exec.Retries() == 0 {
+			// Exceed the timeout with the primary attempt and the hedge
+			<-exec.Canceled()
+			return false, testutil.ErrInvalidState
+		}
+		if exec.IsHedge() {
+			return true, nil
+		}
+		<-exec.Canceled()
+		primaryCanceled.Store(true)
+		return false, testutil.ErrInvalidState
+	})
+
+	// Then
+	assert.True(t, result)
+	assert.NoError(t, err)
+	assert.Eventually(t, primaryCanceled.Load, time.Second, time.Millisecond)
+}
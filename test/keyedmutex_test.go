@@ -0,0 +1,86 @@
+package test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/keyedmutex"
+)
+
+type userKey struct{}
+
+func userFromContext[R any](exec failsafe.Execution[R]) string {
+	return exec.Context().Value(userKey{}).(string)
+}
+
+// Asserts that executions with the same key are serialized, while executions with different keys run concurrently.
+func TestKeyedMutexSerializesPerKey(t *testing.T) {
+	// Given
+	km := keyedmutex.With[any](userFromContext[any])
+	var concurrent, maxConcurrent atomic.Int32
+	concurrentByUser := map[string]*atomic.Int32{"a": {}, "b": {}}
+	var wg sync.WaitGroup
+
+	// When
+	for _, user := range []string{"a", "a", "a", "b", "b", "b"} {
+		user := user
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx := context.WithValue(context.Background(), userKey{}, user)
+			failsafe.NewExecutor[any](km).WithContext(ctx).Run(func() error {
+				assert.Equal(t, int32(1), concurrentByUser[user].Add(1))
+				current := concurrent.Add(1)
+				for {
+					prev := maxConcurrent.Load()
+					if current <= prev || maxConcurrent.CompareAndSwap(prev, current) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+				concurrent.Add(-1)
+				concurrentByUser[user].Add(-1)
+				return nil
+			})
+		}()
+	}
+	wg.Wait()
+
+	// Then
+	assert.Equal(t, int32(2), maxConcurrent.Load())
+}
+
+// Asserts that an execution waiting for a key's lock is canceled when its context is canceled.
+func TestKeyedMutexCancelWhileWaiting(t *testing.T) {
+	// Given
+	km := keyedmutex.With[any](userFromContext[any])
+	ctx := context.WithValue(context.Background(), userKey{}, "a")
+	locked := make(chan struct{})
+	unlock := make(chan struct{})
+	go failsafe.NewExecutor[any](km).WithContext(ctx).Run(func() error {
+		close(locked)
+		<-unlock
+		return nil
+	})
+	<-locked
+
+	// When
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	executed := false
+	err := failsafe.NewExecutor[any](km).WithContext(ctx).Run(func() error {
+		executed = true
+		return nil
+	})
+	close(unlock)
+
+	// Then
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.False(t, executed)
+}
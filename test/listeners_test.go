@@ -8,11 +8,14 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/bulkhead"
 	"github.com/failsafe-go/failsafe-go/circuitbreaker"
 	"github.com/failsafe-go/failsafe-go/fallback"
+	"github.com/failsafe-go/failsafe-go/hedgepolicy"
 	"github.com/failsafe-go/failsafe-go/internal/testutil"
 	"github.com/failsafe-go/failsafe-go/ratelimiter"
 	"github.com/failsafe-go/failsafe-go/retrypolicy"
+	"github.com/failsafe-go/failsafe-go/timeout"
 )
 
 // Asserts that listeners are called the expected number of times for a successful completion.
@@ -27,7 +30,7 @@ func TestListenersOnSuccess(t *testing.T) {
 	registerCbListeners(stats, cbBuilder)
 	registerFbListeners(stats, fbBuilder)
 	executor := failsafe.NewExecutor[bool](fbBuilder.Build(), rpBuilder.Build(), cbBuilder.Build())
-	registerExecutorListeners(stats, executor)
+	executor = registerExecutorListeners(stats, executor)
 
 	// When
 	executor.GetWithExecution(stub)
@@ -66,7 +69,7 @@ func TestListenersForUnhandledFailure(t *testing.T) {
 	registerRpListeners(stats, rpBuilder)
 	registerCbListeners(stats, cbBuilder)
 	executor := failsafe.NewExecutor[bool](rpBuilder.Build(), cbBuilder.Build())
-	registerExecutorListeners(stats, executor)
+	executor = registerExecutorListeners(stats, executor)
 
 	// When
 	executor.GetWithExecution(stub)
@@ -101,7 +104,7 @@ func TestListenersForRetriesExceeded(t *testing.T) {
 	registerRpListeners(stats, rpBuilder)
 	registerCbListeners(stats, cbBuilder)
 	executor := failsafe.NewExecutor[bool](rpBuilder.Build(), cbBuilder.Build())
-	registerExecutorListeners(stats, executor)
+	executor = registerExecutorListeners(stats, executor)
 
 	// When
 	executor.GetWithExecution(stub)
@@ -135,7 +138,7 @@ func TestListenersForAbort(t *testing.T) {
 	registerRpListeners(stats, rpBuilder)
 	registerCbListeners(stats, cbBuilder)
 	executor := failsafe.NewExecutor[bool](rpBuilder.Build(), cbBuilder.Build())
-	registerExecutorListeners(stats, executor)
+	executor = registerExecutorListeners(stats, executor)
 
 	// When
 	executor.GetWithExecution(stub)
@@ -173,7 +176,7 @@ func TestListenersForFailingRetryPolicy(t *testing.T) {
 	registerCbListeners(stats, cbBuilder)
 	registerFbListeners(stats, fbBuilder)
 	executor := failsafe.NewExecutor[bool](fbBuilder.Build(), rpBuilder.Build(), cbBuilder.Build())
-	registerExecutorListeners(stats, executor)
+	executor = registerExecutorListeners(stats, executor)
 
 	// When
 	executor.GetWithExecution(stub)
@@ -208,7 +211,7 @@ func TestListenersForFailingCircuitBreaker(t *testing.T) {
 	registerCbListeners(stats, cbBuilder)
 	registerFbListeners(stats, fbBuilder)
 	executor := failsafe.NewExecutor[bool](fbBuilder.Build(), rpBuilder.Build(), cbBuilder.Build())
-	registerExecutorListeners(stats, executor)
+	executor = registerExecutorListeners(stats, executor)
 
 	// When
 	executor.GetWithExecution(stub)
@@ -242,7 +245,7 @@ func TestListenersForFailingFallback(t *testing.T) {
 	registerCbListeners(stats, cbBuilder)
 	registerFbListeners(stats, fbBuilder)
 	executor := failsafe.NewExecutor[bool](fbBuilder.Build(), rpBuilder.Build(), cbBuilder.Build())
-	registerExecutorListeners(stats, executor)
+	executor = registerExecutorListeners(stats, executor)
 
 	// When
 	executor.GetWithExecution(stub)
@@ -315,7 +318,7 @@ func TestListenersForRateLimiter(t *testing.T) {
 	stats := &listenerStats{}
 	registerRlListeners(stats, rlBuilder)
 	executor := failsafe.NewExecutor[bool](rlBuilder.Build())
-	registerExecutorListeners(stats, executor)
+	executor = registerExecutorListeners(stats, executor)
 
 	// When
 	executor.Run(testutil.RunFn(nil)) // Success
@@ -346,7 +349,7 @@ func TestListenersOnPanic(t *testing.T) {
 	registerCbListeners(stats, cbBuilder)
 	registerFbListeners(stats, fbBuilder)
 	executor := failsafe.NewExecutor[bool](fbBuilder.Build(), rpBuilder.Build(), cbBuilder.Build())
-	registerExecutorListeners(stats, executor)
+	executor = registerExecutorListeners(stats, executor)
 
 	// When
 	assert.PanicsWithValue(t, panicValue, func() {
@@ -377,6 +380,38 @@ func TestListenersOnPanic(t *testing.T) {
 	assert.Equal(t, 0, stats.failure) // Failure listener is not called on a panic
 }
 
+// Asserts which listeners are called when a panic occurs and panics are converted to errors.
+func TestListenersOnPanicAsError(t *testing.T) {
+	// Given - Fail 2 times then panic
+	panicValue := "test panic"
+	stub := testutil.ErrorNTimesThenPanic[bool](testutil.ErrInvalidState, 2, panicValue)
+	rpBuilder := retrypolicy.Builder[bool]().
+		WithMaxAttempts(10).
+		AbortOnErrors(failsafe.ErrPanic)
+	fbBuilder := fallback.BuilderWithResult(true)
+	stats := &listenerStats{}
+	registerRpListeners(stats, rpBuilder)
+	registerFbListeners(stats, fbBuilder)
+	executor := failsafe.NewExecutor[bool](fbBuilder.Build(), rpBuilder.Build()).WithPanicsAsErrors()
+	executor = registerExecutorListeners(stats, executor)
+
+	// When
+	result, err := executor.GetWithExecution(stub)
+
+	// Then
+	assert.True(t, result)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, stats.abort)
+	assert.Equal(t, 2, stats.retry)
+	assert.Equal(t, 0, stats.retriesExceeded)
+	assert.Equal(t, 3, stats.rpFailure)
+	assert.Equal(t, 1, stats.fbDone)
+
+	assert.Equal(t, 1, stats.done)
+	assert.Equal(t, 1, stats.success)
+	assert.Equal(t, 0, stats.failure)
+}
+
 type listenerStats struct {
 	// RetryPolicy
 	abort           int
@@ -408,6 +443,171 @@ type listenerStats struct {
 	failure int
 }
 
+// Asserts that done events identify the policy that produced the final failure.
+func TestDoneEventTerminalPolicy(t *testing.T) {
+	test := func(executor failsafe.Executor[bool], fn func() (bool, error), expectedIndex int, expectedType string) {
+		t.Helper()
+		var event failsafe.ExecutionDoneEvent[bool]
+		executor.WithTerminalPolicyTracking().OnDone(func(e failsafe.ExecutionDoneEvent[bool]) {
+			event = e
+		}).Get(fn)
+		assert.Equal(t, expectedIndex, event.TerminalPolicyIndex())
+		assert.Equal(t, expectedType, event.TerminalPolicyType())
+	}
+	failFn := func() (bool, error) {
+		return false, testutil.ErrInvalidState
+	}
+
+	// Given an open circuit breaker
+	cb := circuitbreaker.WithDefaults[bool]()
+	cb.Open()
+	rp := retrypolicy.Builder[bool]().ReturnLastFailure().Build()
+	test(failsafe.NewExecutor[bool](rp, cb), failFn, 1, "circuitbreaker")
+
+	// Given exceeded retries
+	cb = circuitbreaker.Builder[bool]().WithFailureThreshold(10).Build()
+	test(failsafe.NewExecutor[bool](retrypolicy.WithDefaults[bool](), cb), failFn, 0, "retrypolicy")
+
+	// Given an exceeded timeout
+	to := timeout.With[bool](10 * time.Millisecond)
+	test(failsafe.NewExecutor[bool](retrypolicy.Builder[bool]().ReturnLastFailure().Build(), to), func() (bool, error) {
+		time.Sleep(50 * time.Millisecond)
+		return true, nil
+	}, 1, "timeout")
+
+	// Given a failure from the fn
+	test(failsafe.NewExecutor[bool](retrypolicy.Builder[bool]().ReturnLastFailure().Build()), failFn, -1, "")
+
+	// Given a hedge that's rejected by a bulkhead while the primary attempt fails afterward
+	rejected := make(chan struct{})
+	hp := hedgepolicy.BuilderWithDelay[bool](10 * time.Millisecond).
+		WithMaxHedges(1).
+		CancelOnErrors(testutil.ErrInvalidState).
+		Build()
+	bh := bulkhead.Builder[bool](1).
+		OnFull(func(e failsafe.ExecutionEvent[bool]) {
+			close(rejected)
+		}).
+		Build()
+	test(failsafe.NewExecutor[bool](hp, bh), func() (bool, error) {
+		<-rejected
+		return false, testutil.ErrInvalidState
+	}, -1, "")
+
+	// Given a success
+	test(failsafe.NewExecutor[bool](retrypolicy.WithDefaults[bool]()), func() (bool, error) {
+		return true, nil
+	}, -1, "")
+}
+
+// Asserts that listeners are called in a deterministic order, from the innermost policy outward, then the executor's
+// listeners.
+func TestListenerOrdering(t *testing.T) {
+	// Given
+	var events []string
+	record := func(event string) {
+		events = append(events, event)
+	}
+	rp := retrypolicy.Builder[bool]().
+		WithMaxRetries(2).
+		OnSuccess(func(e failsafe.ExecutionEvent[bool]) { record("rp.success") }).
+		OnFailure(func(e failsafe.ExecutionEvent[bool]) { record("rp.failure") }).
+		OnRetryScheduled(func(e failsafe.ExecutionScheduledEvent[bool]) { record("rp.retryScheduled") }).
+		OnRetry(func(e failsafe.ExecutionEvent[bool]) { record("rp.retry") }).
+		Build()
+	cb := circuitbreaker.Builder[bool]().
+		WithFailureThreshold(2).
+		WithSuccessThreshold(1).
+		OnSuccess(func(e failsafe.ExecutionEvent[bool]) { record("cb.success") }).
+		OnFailure(func(e failsafe.ExecutionEvent[bool]) { record("cb.failure") }).
+		OnOpen(func(e circuitbreaker.StateChangedEvent) { record("cb.open") }).
+		Build()
+	executor := failsafe.NewExecutor[bool](rp, cb).
+		OnSuccess(func(e failsafe.ExecutionDoneEvent[bool]) { record("executor.success") }).
+		OnFailure(func(e failsafe.ExecutionDoneEvent[bool]) { record("executor.failure") }).
+		OnDone(func(e failsafe.ExecutionDoneEvent[bool]) { record("executor.done") })
+	stub, _ := testutil.ErrorNTimesThenReturn[bool](testutil.ErrInvalidState, 1, true)
+
+	// When
+	_, err := executor.GetWithExecution(stub)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"cb.failure",
+		"rp.failure",
+		"rp.retryScheduled",
+		"rp.retry",
+		"cb.success",
+		"rp.success",
+		"executor.success",
+		"executor.done",
+	}, events)
+
+	// When the breaker opens and retries are exceeded
+	events = nil
+	_, err = executor.Get(testutil.GetFn(false, testutil.ErrInvalidState))
+
+	// Then
+	assert.ErrorIs(t, err, retrypolicy.ErrExceeded)
+	assert.Equal(t, []string{
+		"cb.failure",
+		"rp.failure",
+		"rp.retryScheduled",
+		"rp.retry",
+		"cb.failure",
+		"cb.open",
+		"rp.failure",
+		"rp.retryScheduled",
+		"rp.retry",
+		"rp.failure",
+		"executor.failure",
+		"executor.done",
+	}, events)
+
+	// Given a breaker that opens and is closed by a retry after its delay
+	rp = retrypolicy.Builder[bool]().
+		WithDelay(20 * time.Millisecond).
+		OnSuccess(func(e failsafe.ExecutionEvent[bool]) { record("rp.success") }).
+		OnFailure(func(e failsafe.ExecutionEvent[bool]) { record("rp.failure") }).
+		OnRetryScheduled(func(e failsafe.ExecutionScheduledEvent[bool]) { record("rp.retryScheduled") }).
+		OnRetry(func(e failsafe.ExecutionEvent[bool]) { record("rp.retry") }).
+		Build()
+	cb = circuitbreaker.Builder[bool]().
+		WithDelay(10 * time.Millisecond).
+		OnSuccess(func(e failsafe.ExecutionEvent[bool]) { record("cb.success") }).
+		OnFailure(func(e failsafe.ExecutionEvent[bool]) { record("cb.failure") }).
+		OnOpen(func(e circuitbreaker.StateChangedEvent) { record("cb.open") }).
+		OnHalfOpen(func(e circuitbreaker.StateChangedEvent) { record("cb.halfOpen") }).
+		OnClose(func(e circuitbreaker.StateChangedEvent) { record("cb.close") }).
+		Build()
+	executor = failsafe.NewExecutor[bool](rp, cb).
+		OnSuccess(func(e failsafe.ExecutionDoneEvent[bool]) { record("executor.success") }).
+		OnFailure(func(e failsafe.ExecutionDoneEvent[bool]) { record("executor.failure") }).
+		OnDone(func(e failsafe.ExecutionDoneEvent[bool]) { record("executor.done") })
+	stub, _ = testutil.ErrorNTimesThenReturn[bool](testutil.ErrInvalidState, 1, true)
+	events = nil
+
+	// When
+	_, err = executor.GetWithExecution(stub)
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"cb.failure",
+		"cb.open",
+		"rp.failure",
+		"rp.retryScheduled",
+		"rp.retry",
+		"cb.halfOpen",
+		"cb.success",
+		"cb.close",
+		"rp.success",
+		"executor.success",
+		"executor.done",
+	}, events)
+}
+
 func registerRpListeners[R any](stats *listenerStats, rpBuilder retrypolicy.RetryPolicyBuilder[R]) {
 	rpBuilder.OnAbort(func(f failsafe.ExecutionEvent[R]) {
 		stats.abort++
@@ -462,8 +662,8 @@ func registerRlListeners[R any](stats *listenerStats, rlBuilder ratelimiter.Rate
 	})
 }
 
-func registerExecutorListeners[R any](stats *listenerStats, executor failsafe.Executor[R]) {
-	executor.OnDone(func(e failsafe.ExecutionDoneEvent[R]) {
+func registerExecutorListeners[R any](stats *listenerStats, executor failsafe.Executor[R]) failsafe.Executor[R] {
+	return executor.OnDone(func(e failsafe.ExecutionDoneEvent[R]) {
 		stats.done++
 	}).OnFailure(func(e failsafe.ExecutionDoneEvent[R]) {
 		stats.failure++
@@ -0,0 +1,46 @@
+package test
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/loadshedder"
+)
+
+// Asserts that a LoadShedder rejects a fraction of executions that's proportional to the pressure.
+func TestLoadShedderRejectsProportionally(t *testing.T) {
+	// Given
+	var pressure atomic.Value
+	var overloaded atomic.Int32
+	ls := loadshedder.BuilderWithPressureFunc[any](func() float64 {
+		return pressure.Load().(float64)
+	}).
+		WithThreshold(.5).
+		OnOverloaded(func(e failsafe.ExecutionEvent[any]) {
+			overloaded.Add(1)
+		}).
+		Build()
+	executor := failsafe.NewExecutor[any](ls)
+	rejectedFraction := func() float64 {
+		rejected := 0
+		for i := 0; i < 10000; i++ {
+			if err := executor.Run(func() error { return nil }); err != nil {
+				assert.ErrorIs(t, err, loadshedder.ErrOverloaded)
+				rejected++
+			}
+		}
+		return float64(rejected) / 10000
+	}
+
+	// When / Then
+	pressure.Store(.4)
+	assert.Equal(t, float64(0), rejectedFraction())
+	pressure.Store(.75)
+	assert.InDelta(t, .5, rejectedFraction(), .05)
+	pressure.Store(1.0)
+	assert.Equal(t, float64(1), rejectedFraction())
+	assert.Greater(t, overloaded.Load(), int32(10000))
+}
@@ -258,6 +258,31 @@ func TestRetryPolicyHedgePolicy(t *testing.T) {
 		})
 }
 
+// RetryPolicy -> HedgePolicy
+//
+// Asserts that a successful hedge satisfies the RetryPolicy, ending the execution without any retries.
+func TestRetryPolicyHedgePolicyWithHedgeSuccess(t *testing.T) {
+	// Given
+	stats := &policytesting.Stats{}
+	rp := policytesting.WithRetryStatsAndLogs(retrypolicy.Builder[any](), stats).Build()
+	hp := policytesting.WithHedgeStatsAndLogs(hedgepolicy.BuilderWithDelay[any](10*time.Millisecond), stats).Build()
+
+	// When / Then
+	testutil.TestGetSuccess(t, policytesting.SetupFn(stats), failsafe.NewExecutor[any](rp, hp),
+		func(exec failsafe.Execution[any]) (any, error) {
+			if exec.IsHedge() {
+				return "hedge", nil
+			}
+			testutil.WaitAndAssertCanceled(t, time.Second, exec)
+			return nil, testutil.ErrInvalidState
+		}, 2, -1, "hedge", func() {
+			assert.Equal(t, 0, stats.Retries())
+			assert.Equal(t, 1, stats.Hedges())
+			assert.Equal(t, 1, stats.Successes())
+			assert.Equal(t, 0, stats.Failures())
+		})
+}
+
 // CircuitBreaker -> Timeout
 func TestCircuitBreakerTimeout(t *testing.T) {
 	// Given
@@ -2,12 +2,15 @@ package test
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/clock"
 	"github.com/failsafe-go/failsafe-go/internal/testutil"
 	"github.com/failsafe-go/failsafe-go/ratelimiter"
 )
@@ -67,3 +70,103 @@ func TestCancelRateLimiting(t *testing.T) {
 	assert.NoError(t, limiter.AcquirePermit(nil))
 	assert.Error(t, limiter.AcquirePermit(ctx))
 }
+
+// Asserts that executions wait while a rate limiter is paused mid-load, and proceed once it's resumed.
+func TestRateLimiterPauseAndResume(t *testing.T) {
+	// Given
+	limiter := ratelimiter.BurstyBuilder[any](10, time.Second).
+		WithMaxWaitTime(time.Second).
+		WithPauseBehavior(ratelimiter.PauseWait).
+		Build()
+	executor := failsafe.NewExecutor[any](limiter)
+	assert.NoError(t, executor.Run(func() error { return nil }))
+
+	// When
+	limiter.Pause()
+	var executions atomic.Int32
+	results := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		go func() {
+			results <- executor.Run(func() error {
+				executions.Add(1)
+				return nil
+			})
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	// Then
+	assert.Equal(t, int32(0), executions.Load())
+
+	// When
+	limiter.Resume()
+
+	// Then
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, <-results)
+	}
+	assert.Equal(t, int32(5), executions.Load())
+}
+
+type priorityKey struct{}
+
+// Asserts that high priority executions acquire permits before low priority executions that are waiting under saturation.
+func TestRateLimiterWithPriorityFunc(t *testing.T) {
+	// Given
+	limiter := ratelimiter.SmoothBuilderWithMaxRate[any](50 * time.Millisecond).
+		WithMaxWaitTime(time.Second).
+		WithPriorityFunc(func(exec failsafe.Execution[any]) int {
+			return exec.Context().Value(priorityKey{}).(int)
+		}).
+		Build()
+	var mtx sync.Mutex
+	var order []string
+	run := func(name string, priority int, wg *sync.WaitGroup) {
+		defer wg.Done()
+		ctx := context.WithValue(context.Background(), priorityKey{}, priority)
+		err := failsafe.NewExecutor[any](limiter).WithContext(ctx).Run(func() error {
+			mtx.Lock()
+			defer mtx.Unlock()
+			order = append(order, name)
+			return nil
+		})
+		assert.NoError(t, err)
+	}
+	limiter.TryAcquirePermit() // limiter should now be saturated
+
+	// When
+	var wg sync.WaitGroup
+	wg.Add(5)
+	go run("first", 0, &wg) // Takes the turn to wait for the next permit
+	time.Sleep(10 * time.Millisecond)
+	go run("low1", 0, &wg)
+	time.Sleep(10 * time.Millisecond)
+	go run("low2", 0, &wg)
+	time.Sleep(10 * time.Millisecond)
+	go run("high", 10, &wg)
+	time.Sleep(10 * time.Millisecond)
+	go run("medium", 5, &wg)
+	wg.Wait()
+
+	// Then
+	assert.Equal(t, []string{"first", "high", "medium", "low1", "low2"}, order)
+}
+
+// Asserts that reservations provide the time to wait for permits, so that callers can decide whether to drop or delay.
+func TestRateLimiterReservations(t *testing.T) {
+	// Given
+	fakeClock := clock.NewFakeClock(time.Now())
+	limiter := ratelimiter.SmoothBuilderWithMaxRate[any](100 * time.Millisecond).WithClock(fakeClock).Build()
+
+	// When / Then
+	assert.Equal(t, time.Duration(0), limiter.ReservePermit())
+	assert.Equal(t, 100*time.Millisecond, limiter.ReservePermit())
+	assert.Equal(t, time.Duration(-1), limiter.TryReservePermit(150*time.Millisecond), "should drop")
+	assert.Equal(t, 200*time.Millisecond, limiter.TryReservePermit(250*time.Millisecond), "should delay")
+
+	// When the reserved permits are used
+	fakeClock.Advance(300 * time.Millisecond)
+
+	// Then
+	assert.Equal(t, time.Duration(0), limiter.ReservePermit())
+}
@@ -0,0 +1,64 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/resourcetime"
+)
+
+// Asserts that a long execution exhausts the budget, which delays the next execution until the budget is refilled.
+func TestResourceTimeLimiterDelaysAfterBudgetExhausted(t *testing.T) {
+	// Given
+	rtl := resourcetime.Builder[any](50*time.Millisecond, 100*time.Millisecond).
+		WithMaxWaitTime(time.Second).
+		Build()
+	executor := failsafe.NewExecutor[any](rtl)
+
+	// When
+	err := executor.Run(func() error {
+		time.Sleep(200 * time.Millisecond)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Less(t, rtl.Available(), time.Duration(0))
+	startTime := time.Now()
+	err = executor.Run(func() error {
+		return nil
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(startTime), 250*time.Millisecond)
+}
+
+// Asserts that executions are rejected while the budget is depleted, and the execution duration is weighted.
+func TestResourceTimeLimiterRejectsWhenBudgetExhausted(t *testing.T) {
+	// Given
+	exceeded := 0
+	rtl := resourcetime.Builder[any](time.Second, time.Hour).
+		WithWeight(10).
+		OnBudgetExceeded(func(e failsafe.ExecutionEvent[any]) {
+			exceeded++
+		}).
+		Build()
+	executor := failsafe.NewExecutor[any](rtl)
+
+	// When
+	err1 := executor.Run(func() error {
+		time.Sleep(110 * time.Millisecond)
+		return nil
+	})
+	err2 := executor.Run(func() error {
+		return nil
+	})
+
+	// Then
+	assert.NoError(t, err1)
+	assert.ErrorIs(t, err2, resourcetime.ErrExceeded)
+	assert.Equal(t, 1, exceeded)
+	assert.False(t, rtl.TryAcquirePermit())
+}
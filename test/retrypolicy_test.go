@@ -3,6 +3,8 @@ package test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -121,6 +123,25 @@ func TestShouldFailWhenMaxDurationExceeded(t *testing.T) {
 		2, 2, &retrypolicy.ExceededError{})
 }
 
+// Asserts that the max duration bounds the total time across attempts, regardless of the remaining attempts and backoff.
+func TestMaxDurationBoundsBackoff(t *testing.T) {
+	// Given
+	rp := retrypolicy.Builder[any]().
+		WithMaxAttempts(20).
+		WithBackoff(10*time.Millisecond, time.Second).
+		WithMaxDuration(100 * time.Millisecond).
+		Build()
+
+	// When
+	start := time.Now()
+	result := failsafe.NewExecutor[any](rp).RunWithResult(testutil.RunFn(testutil.ErrInvalidState))
+
+	// Then the backoff delays would otherwise add up to over 10 seconds
+	assert.ErrorIs(t, result.Error, retrypolicy.ErrExceeded)
+	assert.Less(t, result.Attempts(), 20)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
 // Asserts that the last failure is returned
 func TestShouldReturnLastFailure(t *testing.T) {
 	// Given
@@ -171,3 +192,326 @@ func TestBackoffDelay(t *testing.T) {
 	expected := []time.Duration{time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond, 8 * time.Millisecond, 10 * time.Millisecond, 10 * time.Millisecond}
 	assert.ElementsMatch(t, expected, delays)
 }
+
+// Asserts that a RetryPolicy with a StateStore resumes retries from stored state, as it would after a process restart.
+func TestShouldResumeRetriesFromStateStore(t *testing.T) {
+	// Given
+	store := &testStateStore{states: make(map[string]retrypolicy.RetryState)}
+	newExecutor := func(ctx context.Context, onRetryScheduled func(failsafe.ExecutionScheduledEvent[bool])) failsafe.Executor[bool] {
+		rp := retrypolicy.Builder[bool]().
+			WithMaxRetries(3).
+			WithDelay(10 * time.Millisecond).
+			WithStateStore(store).
+			OnRetryScheduled(onRetryScheduled).
+			Build()
+		return failsafe.NewExecutor[bool](rp).WithContext(retrypolicy.ContextWithStateKey(ctx, "key"))
+	}
+	fn := func(exec failsafe.Execution[bool]) (bool, error) {
+		return false, testutil.ErrConnecting
+	}
+
+	// When the first process stops after 2 failed attempts
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	_, err := newExecutor(ctx, func(e failsafe.ExecutionScheduledEvent[bool]) {
+		attempts = e.Attempts()
+		if attempts == 2 {
+			cancel()
+		}
+	}).GetWithExecution(fn)
+
+	// Then
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 2, attempts)
+	state, ok := store.Load("key")
+	assert.True(t, ok)
+	assert.Equal(t, 2, state.FailedAttempts)
+
+	// When the second process resumes
+	attempts = 0
+	_, err = newExecutor(context.Background(), func(e failsafe.ExecutionScheduledEvent[bool]) {
+		attempts = e.Attempts()
+	}).GetWithExecution(fn)
+
+	// Then
+	assert.ErrorIs(t, err, retrypolicy.ErrExceeded)
+	assert.Equal(t, 1, attempts)
+	_, ok = store.Load("key")
+	assert.False(t, ok)
+}
+
+type testStateStore struct {
+	mtx    sync.Mutex
+	states map[string]retrypolicy.RetryState
+}
+
+func (s *testStateStore) Load(key string) (retrypolicy.RetryState, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	state, ok := s.states[key]
+	return state, ok
+}
+
+func (s *testStateStore) Save(key string, state retrypolicy.RetryState) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.states[key] = state
+}
+
+func (s *testStateStore) Delete(key string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	delete(s.states, key)
+}
+
+// Asserts that a retried execution can resume from the progress recorded by a previous attempt.
+func TestShouldResumeFromProgress(t *testing.T) {
+	// Given
+	data := []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+	var resumedFrom []int64
+	transfer := func(exec failsafe.Execution[[]byte]) ([]byte, error) {
+		offset := exec.ResumeFrom()
+		resumedFrom = append(resumedFrom, offset)
+		var received []byte
+		for i := offset; i < int64(len(data)); i += 4 {
+			// Fail mid-stream on the first attempt
+			if exec.IsFirstAttempt() && i >= 16 {
+				return nil, testutil.ErrConnecting
+			}
+			end := min(i+4, int64(len(data)))
+			received = append(received, data[i:end]...)
+			exec.SetProgress(end)
+		}
+		return received, nil
+	}
+
+	// When
+	result, err := failsafe.GetWithExecution(transfer, retrypolicy.WithDefaults[[]byte]())
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, data[16:], result)
+	assert.Equal(t, []int64{0, 16}, resumedFrom)
+}
+
+// Asserts that retries are skipped for an error that has recently occurred across concurrent executions.
+func TestShouldSkipRetriesForRecentFailures(t *testing.T) {
+	// Given
+	rp := retrypolicy.Builder[bool]().
+		WithRecentFailureAbort(10, 3).
+		Build()
+	executor := failsafe.NewExecutor[bool](rp)
+	fn := func() (bool, error) {
+		return false, testutil.ErrConnecting
+	}
+
+	// When
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := executor.Get(fn)
+			assert.ErrorIs(t, err, testutil.ErrConnecting)
+		}()
+	}
+	wg.Wait()
+
+	// Then
+	attempts := 0
+	executor = executor.OnDone(func(e failsafe.ExecutionDoneEvent[bool]) {
+		attempts = e.Attempts()
+	})
+	_, err := executor.Get(fn)
+	assert.ErrorIs(t, err, testutil.ErrConnecting)
+	assert.Equal(t, 1, attempts)
+
+	// Other errors are still retried
+	_, err = executor.Get(func() (bool, error) {
+		return false, testutil.ErrInvalidState
+	})
+	assert.ErrorIs(t, err, retrypolicy.ErrExceeded)
+	assert.Equal(t, 3, attempts)
+}
+
+// Asserts that a next retry time set by an execution, such as from a rate limit reset header, controls the retry delay,
+// bounded by the max delay.
+func TestShouldRetryAtNextRetryTime(t *testing.T) {
+	// Given
+	rp := retrypolicy.Builder[bool]().
+		WithBackoff(10*time.Millisecond, 200*time.Millisecond).
+		OnRetryScheduled(func(e failsafe.ExecutionScheduledEvent[bool]) {
+			// Then
+			if e.Attempts() == 1 {
+				assert.InDelta(t, 100*time.Millisecond, e.Delay, float64(20*time.Millisecond))
+			} else {
+				assert.Equal(t, 200*time.Millisecond, e.Delay)
+			}
+		}).
+		Build()
+	fn := func(exec failsafe.Execution[bool]) (bool, error) {
+		if exec.Attempts() == 1 {
+			exec.SetNextRetryTime(time.Now().Add(100 * time.Millisecond))
+		} else if exec.Attempts() == 2 {
+			exec.SetNextRetryTime(time.Now().Add(time.Hour))
+		} else {
+			return true, nil
+		}
+		return false, testutil.ErrConnecting
+	}
+
+	// When
+	var result bool
+	var err error
+	elapsed := testutil.Timed(func() {
+		result, err = failsafe.GetWithExecution(fn, rp)
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.True(t, result)
+	assert.GreaterOrEqual(t, elapsed, 300*time.Millisecond)
+	assert.Less(t, elapsed, time.Second)
+}
+
+// Asserts that executions can tell whether an attempt is the first or last across retries that are exhausted.
+func TestIsFirstAndLastAttempt(t *testing.T) {
+	type attemptFlags struct {
+		first bool
+		last  bool
+	}
+
+	t.Run("with max retries", func(t *testing.T) {
+		// Given
+		rp := retrypolicy.Builder[any]().WithMaxAttempts(3).Build()
+		var flags []attemptFlags
+
+		// When
+		err := failsafe.RunWithExecution(func(exec failsafe.Execution[any]) error {
+			flags = append(flags, attemptFlags{exec.IsFirstAttempt(), exec.IsLastAttempt()})
+			return testutil.ErrInvalidState
+		}, rp)
+
+		// Then
+		assert.ErrorIs(t, err, retrypolicy.ErrExceeded)
+		assert.Equal(t, []attemptFlags{{true, false}, {false, false}, {false, true}}, flags)
+	})
+
+	t.Run("with nested retry policies", func(t *testing.T) {
+		// Given
+		outer := retrypolicy.Builder[any]().WithMaxRetries(1).ReturnLastFailure().Build()
+		inner := retrypolicy.Builder[any]().WithMaxRetries(1).ReturnLastFailure().Build()
+		var lastFlags []bool
+
+		// When
+		failsafe.RunWithExecution(func(exec failsafe.Execution[any]) error {
+			lastFlags = append(lastFlags, exec.IsLastAttempt())
+			return testutil.ErrInvalidState
+		}, outer, inner)
+
+		// Then the inner policy's retries are exhausted after 2 attempts, leaving 1 outer retry
+		assert.Equal(t, []bool{false, false, true}, lastFlags)
+	})
+
+	t.Run("with a retry budget", func(t *testing.T) {
+		// Given
+		budget := retrypolicy.NewRetryBudget(0, 1, 2*time.Second)
+		rp := retrypolicy.Builder[any]().WithMaxRetries(5).WithBudget(budget).Build()
+		var lastFlags []bool
+
+		// When
+		err := failsafe.RunWithExecution(func(exec failsafe.Execution[any]) error {
+			lastFlags = append(lastFlags, exec.IsLastAttempt())
+			return testutil.ErrInvalidState
+		}, rp)
+
+		// Then the budget only permits 2 retries
+		assert.ErrorIs(t, err, retrypolicy.ErrExceeded)
+		assert.Equal(t, []bool{false, false, true}, lastFlags)
+	})
+
+	t.Run("without a retry policy", func(t *testing.T) {
+		failsafe.RunWithExecution(func(exec failsafe.Execution[any]) error {
+			assert.True(t, exec.IsFirstAttempt())
+			assert.True(t, exec.IsLastAttempt())
+			return nil
+		})
+	})
+}
+
+// Asserts that retry delays are determined by the error of the failed attempt when error backoffs are configured.
+func TestShouldUseErrorBackoff(t *testing.T) {
+	// Given
+	var delays []time.Duration
+	rp := retrypolicy.Builder[any]().
+		WithMaxRetries(-1).
+		WithDelay(time.Millisecond).
+		WithErrorBackoff(map[error]retrypolicy.BackoffConfig{
+			testutil.ErrConnecting:   {Delay: 10 * time.Millisecond, MaxDelay: 30 * time.Millisecond},
+			testutil.ErrInvalidState: {Delay: 40 * time.Millisecond},
+		}).
+		OnRetryScheduled(func(e failsafe.ExecutionScheduledEvent[any]) {
+			delays = append(delays, e.Delay)
+		}).
+		Build()
+	errs := []error{
+		testutil.ErrConnecting,
+		testutil.ErrConnecting,
+		fmt.Errorf("wrapped: %w", testutil.ErrConnecting),
+		testutil.ErrInvalidState,
+		testutil.ErrInvalidArgument,
+		errors.Join(testutil.ErrConnecting, testutil.ErrInvalidState),
+	}
+
+	// When
+	err := failsafe.NewExecutor[any](rp).RunWithExecution(func(exec failsafe.Execution[any]) error {
+		if attempt := exec.Attempts(); attempt <= len(errs) {
+			return errs[attempt-1]
+		}
+		return nil
+	})
+
+	// Then
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Duration{
+		10 * time.Millisecond,
+		20 * time.Millisecond,
+		30 * time.Millisecond,
+		40 * time.Millisecond,
+		time.Millisecond,
+		40 * time.Millisecond, // The longest matching delay is used
+	}, delays)
+}
+
+// Asserts that RetryPolicies which share a RetryBudget are collectively limited by it.
+func TestRetryBudgetSharedAcrossPolicies(t *testing.T) {
+	// Given
+	budget := retrypolicy.NewRetryBudget(0, 1, 3*time.Second)
+	exceeded := 0
+	newExecutor := func() failsafe.Executor[any] {
+		rp := retrypolicy.Builder[any]().
+			WithMaxRetries(5).
+			WithBudget(budget).
+			OnRetriesExceeded(func(e failsafe.ExecutionEvent[any]) {
+				exceeded++
+			}).
+			Build()
+		return failsafe.NewExecutor[any](rp)
+	}
+	attempts := 0
+	fn := func() error {
+		attempts++
+		return testutil.ErrInvalidState
+	}
+
+	// When
+	err1 := newExecutor().Run(fn)
+	err2 := newExecutor().Run(fn)
+
+	// Then
+	assert.ErrorIs(t, err1, retrypolicy.ErrExceeded)
+	assert.ErrorIs(t, err2, retrypolicy.ErrExceeded)
+	assert.Equal(t, 5, attempts, "should only retry 3 times across both policies")
+	assert.Equal(t, 2, exceeded)
+	assert.Equal(t, 0, budget.Balance())
+}
@@ -3,6 +3,7 @@ package test
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -236,3 +237,191 @@ func TestTimeoutFallbackWithBlockedFallback(t *testing.T) {
 			assert.Equal(t, 0, fbStats.Executions())
 		})
 }
+
+// Asserts that the attempt deadline is bounded by a RetryPolicy's remaining max duration when it's tighter than the
+// Timeout.
+func TestTimeoutDeadlineBoundedByRetryMaxDuration(t *testing.T) {
+	// Given
+	to := timeout.With[any](time.Second)
+	rp := retrypolicy.Builder[any]().WithMaxDuration(100 * time.Millisecond).Build()
+
+	// When
+	var deadline time.Time
+	elapsed := testutil.Timed(func() {
+		_, err := failsafe.NewExecutor[any](rp, to).GetWithExecution(func(exec failsafe.Execution[any]) (any, error) {
+			var ok bool
+			deadline, ok = exec.Context().Deadline()
+			assert.True(t, ok)
+			<-exec.Canceled()
+			return nil, exec.Context().Err()
+		})
+
+		// Then
+		assert.ErrorIs(t, err, retrypolicy.ErrExceeded)
+		assert.ErrorIs(t, err, timeout.ErrExceeded)
+	})
+	assert.True(t, time.Until(deadline) < 0)
+	assert.Less(t, elapsed, 500*time.Millisecond)
+}
+
+// Asserts that the attempt deadline is the Timeout's time limit when it's tighter than a RetryPolicy's remaining max
+// duration.
+func TestTimeoutDeadlineTighterThanRetryMaxDuration(t *testing.T) {
+	// Given
+	to := timeout.With[any](50 * time.Millisecond)
+	rp := retrypolicy.Builder[any]().WithMaxDuration(time.Second).Build()
+
+	// When / Then
+	failsafe.NewExecutor[any](rp, to).RunWithExecution(func(exec failsafe.Execution[any]) error {
+		deadline, ok := exec.Context().Deadline()
+		assert.True(t, ok)
+		assert.LessOrEqual(t, time.Until(deadline), 50*time.Millisecond)
+		return nil
+	})
+}
+
+// Asserts that a Timeout configured to use the returned result returns a partial result from a canceled execution.
+func TestTimeoutWithUseReturnedResult(t *testing.T) {
+	// Given
+	to := timeout.Builder[string](50 * time.Millisecond).WithUseReturnedResult().Build()
+	fn := func(exec failsafe.Execution[string]) (string, error) {
+		<-exec.Canceled()
+		return "partial", exec.Context().Err()
+	}
+
+	// When
+	result, err := failsafe.NewExecutor[string](to).GetWithExecution(fn)
+
+	// Then
+	assert.Equal(t, "partial", result)
+	assert.ErrorIs(t, err, timeout.ErrExceeded)
+
+	// When
+	result, err = failsafe.NewExecutor[string](timeout.With[string](50 * time.Millisecond)).GetWithExecution(fn)
+
+	// Then
+	assert.Equal(t, "", result)
+	assert.ErrorIs(t, err, timeout.ErrExceeded)
+}
+
+// Asserts that a Timeout can use a timer that fires immediately, without waiting for the time limit.
+func TestTimeoutWithTimer(t *testing.T) {
+	// Given
+	fireImmediately := func(d time.Duration, f func()) *time.Timer {
+		f()
+		return time.NewTimer(d)
+	}
+	to := timeout.Builder[any](time.Hour).WithTimer(fireImmediately).Build()
+	rp := retrypolicy.Builder[any]().ReturnLastFailure().Build()
+	var attempts int
+
+	// When
+	var err error
+	elapsed := testutil.Timed(func() {
+		err = failsafe.NewExecutor[any](rp, to).RunWithExecution(func(exec failsafe.Execution[any]) error {
+			attempts++
+			<-exec.Canceled()
+			return nil
+		})
+	})
+
+	// Then
+	assert.ErrorIs(t, err, timeout.ErrExceeded)
+	assert.Equal(t, 3, attempts)
+	assert.Less(t, elapsed, time.Second)
+}
+
+func TestTimeoutExceededError(t *testing.T) {
+	// Given
+	to := timeout.With[any](50 * time.Millisecond)
+
+	// When
+	_, err := failsafe.Get(func() (any, error) {
+		time.Sleep(200 * time.Millisecond)
+		return nil, nil
+	}, to)
+
+	// Then
+	assert.ErrorIs(t, err, timeout.ErrExceeded)
+	var exceededErr *timeout.ExceededError
+	assert.True(t, errors.As(err, &exceededErr))
+	assert.Equal(t, 50*time.Millisecond, exceededErr.Limit())
+	assert.GreaterOrEqual(t, exceededErr.Elapsed(), 50*time.Millisecond)
+	assert.Less(t, exceededErr.Elapsed(), 200*time.Millisecond)
+}
+
+// Asserts that attempts which ignore cancellation are abandoned, and that a listener is called when too many are abandoned.
+func TestTimeoutWithAbandonGoroutine(t *testing.T) {
+	// Given
+	var events []timeout.AbandonedExceededEvent
+	to := timeout.Builder[any](20 * time.Millisecond).
+		WithAbandonGoroutine(1).
+		OnAbandonedExceeded(func(e timeout.AbandonedExceededEvent) {
+			events = append(events, e)
+		}).
+		Build()
+	release := make(chan struct{})
+	executor := failsafe.NewExecutor[any](to)
+	run := func() error {
+		return executor.Run(func() error {
+			// Ignore cancellation
+			<-release
+			return nil
+		})
+	}
+
+	// When
+	err1 := run()
+	err2 := run()
+
+	// Then
+	assert.ErrorIs(t, err1, timeout.ErrExceeded)
+	assert.ErrorIs(t, err2, timeout.ErrExceeded)
+	assert.Equal(t, 2, to.Abandoned())
+	assert.Equal(t, []timeout.AbandonedExceededEvent{{Abandoned: 2, MaxAbandoned: 1}}, events)
+
+	// When
+	close(release)
+
+	// Then
+	assert.Eventually(t, func() bool {
+		return to.Abandoned() == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+// Asserts that a Timeout's time limit is bounded by an earlier context deadline, and that the execution returns the
+// context's error when the deadline is reached.
+func TestTimeoutBoundedByContextDeadline(t *testing.T) {
+	// Given
+	var timeoutExceeded atomic.Bool
+	var attemptDeadline atomic.Pointer[time.Time]
+	rp := retrypolicy.Builder[any]().WithMaxRetries(3).Build()
+	to := timeout.Builder[any](time.Second).
+		WithAbandonGoroutine(10).
+		OnTimeoutExceeded(func(e failsafe.ExecutionDoneEvent[any]) {
+			timeoutExceeded.Store(true)
+		}).
+		Build()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	ctxDeadline, _ := ctx.Deadline()
+	executor := failsafe.NewExecutor[any](rp, to).WithContext(ctx)
+
+	// When
+	start := time.Now()
+	var attempts atomic.Int32
+	err := executor.RunWithExecution(func(exec failsafe.Execution[any]) error {
+		attempts.Add(1)
+		deadline, _ := exec.Context().Deadline()
+		attemptDeadline.Store(&deadline)
+		time.Sleep(300 * time.Millisecond) // Ignore cancellation
+		return nil
+	})
+
+	// Then
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 200*time.Millisecond)
+	assert.Equal(t, int32(1), attempts.Load())
+	assert.Equal(t, ctxDeadline, *attemptDeadline.Load())
+	assert.False(t, timeoutExceeded.Load())
+}
@@ -2,41 +2,127 @@ package timeout
 
 import (
 	"errors"
+	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/failsafe-go/failsafe-go"
+	"github.com/failsafe-go/failsafe-go/clock"
 	"github.com/failsafe-go/failsafe-go/policy"
 )
 
-// ErrExceeded is returned when an execution exceeds a configured timeout.
+// ErrExceeded is wrapped by the ExceededError that is returned when an execution exceeds a configured timeout.
 var ErrExceeded = errors.New("timeout exceeded")
 
+// ExceededError is returned when an execution exceeds a configured timeout, and provides details about the time limit
+// and elapsed time. ExceededError wraps ErrExceeded, so errors.Is(err, ErrExceeded) can be used to check for it.
+type ExceededError struct {
+	limit   time.Duration
+	elapsed time.Duration
+}
+
+// Limit returns the time limit that was exceeded. This is the Timeout's configured time limit, unless it was bounded
+// by an outer RetryPolicy's max duration.
+func (e *ExceededError) Limit() time.Duration {
+	return e.limit
+}
+
+// Elapsed returns the time from when the Timeout started the execution until the Timeout was exceeded.
+func (e *ExceededError) Elapsed() time.Duration {
+	return e.elapsed
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("%v after %v (limit %v)", ErrExceeded, e.elapsed, e.limit)
+}
+
+func (e *ExceededError) Unwrap() error {
+	return ErrExceeded
+}
+
 // Timeout is a Policy that cancels executions if they exceed a time limit. Any policies composed inside the timeout,
 // such as retries, will also be canceled. If the execution is configured with a Context, a child context will be created
 // for the execution and canceled when the Timeout is exceeded.
 //
+// Each execution's context reports a deadline for when the Timeout will be exceeded. When a Timeout is composed inside a
+// RetryPolicy that has a max duration, the time limit for each attempt is bounded by the remaining max duration, so that an
+// attempt will not run longer than the overall retry budget allows.
+//
+// The time limit is also bounded by any earlier deadline of the execution's Context. When that deadline is reached before
+// the time limit, the execution is canceled with context.DeadlineExceeded rather than ErrExceeded, even if the execution's
+// func does not observe cancellation. When a Timeout is composed inside a RetryPolicy, this shortens the time limit for
+// an attempt that would otherwise run past the Context's deadline, and the RetryPolicy does not retry once the deadline
+// is reached, since the execution is canceled.
+//
 // This type is concurrency safe.
 type Timeout[R any] interface {
 	failsafe.Policy[R]
+
+	// Abandoned returns the number of execution attempts that were abandoned when the Timeout was exceeded, via
+	// WithAbandonGoroutine, and have not yet returned.
+	Abandoned() int
 }
 
 type TimeoutBuilder[R any] interface {
 	// OnTimeoutExceeded registers the listener to be called when the timeout is exceeded.
 	OnTimeoutExceeded(listener func(event failsafe.ExecutionDoneEvent[R])) TimeoutBuilder[R]
 
+	// WithUseReturnedResult configures the Timeout to return the result that is returned by the execution after it's
+	// canceled, along with ErrExceeded, rather than the zero value for R. This allows an execution that observes
+	// cancellation, via Execution.Canceled or its Context, to return a best-effort partial result. The Timeout still waits
+	// for the execution to return before returning.
+	WithUseReturnedResult() TimeoutBuilder[R]
+
+	// WithTimer configures the timerFunc that is used to schedule the Timeout, which is time.AfterFunc by default. The
+	// timerFunc should call f after the duration d, and return a timer that can be used to stop f from being called. This
+	// is useful for controlling when a Timeout is exceeded in tests, without real delays.
+	WithTimer(timerFunc func(d time.Duration, f func()) *time.Timer) TimeoutBuilder[R]
+
+	// WithClock configures the clock that is used to schedule the Timeout and measure elapsed time. This is useful for
+	// controlling when a Timeout is exceeded in tests, via a clock.FakeClock. The default is clock.New(). A timerFunc
+	// configured via WithTimer takes precedence over the clock for scheduling the Timeout.
+	WithClock(clock clock.Clock) TimeoutBuilder[R]
+
+	// WithAbandonGoroutine configures the Timeout to perform each execution attempt in a separate goroutine, and to abandon
+	// the attempt when the Timeout is exceeded, returning ErrExceeded without waiting for the attempt to return. This
+	// limits the impact of executions that ignore cancellation, such as funcs that deadlock, on their callers. Since a
+	// goroutine cannot be killed, an abandoned attempt continues to run, and to consume resources, until it returns. If the
+	// number of abandoned attempts that have not yet returned exceeds maxAbandoned, the OnAbandonedExceeded listener is
+	// called, which can be used to detect goroutine leaks. WithUseReturnedResult has no effect for abandoned attempts.
+	WithAbandonGoroutine(maxAbandoned int) TimeoutBuilder[R]
+
+	// OnAbandonedExceeded registers the listener to be called when an attempt is abandoned and the number of abandoned
+	// attempts that have not yet returned exceeds the maxAbandoned configured via WithAbandonGoroutine.
+	OnAbandonedExceeded(listener func(event AbandonedExceededEvent)) TimeoutBuilder[R]
+
 	// Build returns a new Timeout using the builder's configuration.
 	Build() Timeout[R]
 }
 
+// AbandonedExceededEvent indicates the number of abandoned attempts for a Timeout has exceeded the configured max.
+type AbandonedExceededEvent struct {
+	// Abandoned is the number of abandoned attempts that have not yet returned.
+	Abandoned int
+	// MaxAbandoned is the configured max number of abandoned attempts.
+	MaxAbandoned int
+}
+
 type timeoutConfig[R any] struct {
-	timeLimit         time.Duration
-	onTimeoutExceeded func(failsafe.ExecutionDoneEvent[R])
+	timeLimit           time.Duration
+	useReturnedResult   bool
+	timerFunc           func(time.Duration, func()) *time.Timer
+	clock               clock.Clock
+	abandonGoroutine    bool
+	maxAbandoned        int
+	onTimeoutExceeded   func(failsafe.ExecutionDoneEvent[R])
+	onAbandonedExceeded func(AbandonedExceededEvent)
 }
 
 var _ TimeoutBuilder[any] = &timeoutConfig[any]{}
 
 type timeout[R any] struct {
-	config *timeoutConfig[R]
+	config    *timeoutConfig[R]
+	abandoned atomic.Int32
 }
 
 // With returns a new Timeout for execution result type R and the timeLimit. The Timeout will cancel executions if they
@@ -54,6 +140,7 @@ func With[R any](timeLimit time.Duration) Timeout[R] {
 func Builder[R any](timeLimit time.Duration) TimeoutBuilder[R] {
 	return &timeoutConfig[R]{
 		timeLimit: timeLimit,
+		clock:     clock.New(),
 	}
 }
 
@@ -62,6 +149,32 @@ func (c *timeoutConfig[R]) OnTimeoutExceeded(listener func(event failsafe.Execut
 	return c
 }
 
+func (c *timeoutConfig[R]) WithUseReturnedResult() TimeoutBuilder[R] {
+	c.useReturnedResult = true
+	return c
+}
+
+func (c *timeoutConfig[R]) WithClock(clock clock.Clock) TimeoutBuilder[R] {
+	c.clock = clock
+	return c
+}
+
+func (c *timeoutConfig[R]) WithTimer(timerFunc func(d time.Duration, f func()) *time.Timer) TimeoutBuilder[R] {
+	c.timerFunc = timerFunc
+	return c
+}
+
+func (c *timeoutConfig[R]) WithAbandonGoroutine(maxAbandoned int) TimeoutBuilder[R] {
+	c.abandonGoroutine = true
+	c.maxAbandoned = maxAbandoned
+	return c
+}
+
+func (c *timeoutConfig[R]) OnAbandonedExceeded(listener func(event AbandonedExceededEvent)) TimeoutBuilder[R] {
+	c.onAbandonedExceeded = listener
+	return c
+}
+
 func (c *timeoutConfig[R]) Build() Timeout[R] {
 	fbCopy := *c
 	return &timeout[R]{
@@ -69,6 +182,20 @@ func (c *timeoutConfig[R]) Build() Timeout[R] {
 	}
 }
 
+func (t *timeout[R]) Abandoned() int {
+	return int(t.abandoned.Load())
+}
+
+// DescribeConfig describes the Timeout's configuration for failsafe.Executor.ConfigJSON.
+func (t *timeout[R]) DescribeConfig() map[string]any {
+	return map[string]any{
+		"timeLimit":         t.config.timeLimit.String(),
+		"useReturnedResult": t.config.useReturnedResult,
+		"abandonGoroutine":  t.config.abandonGoroutine,
+		"maxAbandoned":      t.config.maxAbandoned,
+	}
+}
+
 func (t *timeout[R]) ToExecutor(_ R) any {
 	te := &timeoutExecutor[R]{
 		BaseExecutor: &policy.BaseExecutor[R]{},
@@ -1,6 +1,7 @@
 package timeout
 
 import (
+	"context"
 	"errors"
 	"sync/atomic"
 	"time"
@@ -24,33 +25,129 @@ func (e *timeoutExecutor[R]) Apply(innerFn func(failsafe.Execution[R]) *common.P
 	return func(exec failsafe.Execution[R]) *common.PolicyResult[R] {
 		execInternal := exec.(policy.ExecutionInternal[R])
 
+		// Bound the time limit by any budget deadline, such as from an outer RetryPolicy's max duration
+		timeLimit := e.config.timeLimit
+		if deadline, ok := execInternal.BudgetDeadline(); ok {
+			timeLimit = max(0, min(timeLimit, time.Until(deadline)))
+		}
+
+		// Bound the time limit by any earlier deadline of the execution's context
+		var ctxErr error
+		ctx := exec.Context()
+		if deadline, ok := execInternal.ContextDeadline(); ok {
+			if untilDeadline := time.Until(deadline); untilDeadline < timeLimit {
+				timeLimit = max(0, untilDeadline)
+				ctxErr = context.DeadlineExceeded
+			}
+		}
+
 		// Create child context
-		execInternal = execInternal.CopyForCancellable().(policy.ExecutionInternal[R])
+		execInternal = execInternal.CopyForCancellableWithTimeout(timeLimit).(policy.ExecutionInternal[R])
 		var result atomic.Pointer[common.PolicyResult[R]]
-		timer := time.AfterFunc(e.config.timeLimit, func() {
-			timeoutResult := internal.FailureResult[R](ErrExceeded)
+		exceeded := make(chan struct{})
+		startTime := e.config.clock.Now()
+		timer := e.schedule(timeLimit, func() {
+			if ctxErr != nil {
+				// Cancel with the context's error, since the context's deadline was reached rather than the time limit. Wait for
+				// the context to be done first, since its own timer may fire after this one, so that outer policies observe it.
+				<-ctx.Done()
+				ctxResult := internal.FailureResult[R](ctxErr)
+				if result.CompareAndSwap(nil, ctxResult) {
+					execInternal.Cancel(ctxResult)
+					close(exceeded)
+				}
+				return
+			}
+
+			err := &ExceededError{
+				limit:   timeLimit,
+				elapsed: e.config.clock.Since(startTime),
+			}
+			timeoutResult := internal.FailureResult[R](err)
 			if result.CompareAndSwap(nil, timeoutResult) {
 				// Sets the timeoutResult, overwriting any previously set result for the execution. This is correct, because while an
 				// execution may have completed, inner policies such as fallbacks may still be processing that result, in which case
 				// it's still important to interrupt them with a timeout.
 				execInternal.Cancel(timeoutResult)
+				close(exceeded)
+				if metrics := execInternal.Metrics(); metrics != nil {
+					metrics.IncrementCounter(failsafe.MetricTimeoutsExceeded, nil)
+				}
+				if logger := execInternal.Logger(); logger != nil {
+					logger.Debug("timeout exceeded", "policy", "Timeout", "attempt", execInternal.Attempts(), "limit", timeLimit)
+				}
 				if e.config.onTimeoutExceeded != nil {
 					e.config.onTimeoutExceeded(failsafe.ExecutionDoneEvent[R]{
 						ExecutionStats: execInternal,
-						Error:          ErrExceeded,
+						Error:          err,
 					})
 				}
 			}
 		})
 
 		// Store result and ctxCancel timeout context if needed
-		if result.CompareAndSwap(nil, innerFn(execInternal)) {
+		var innerResult *common.PolicyResult[R]
+		if e.config.abandonGoroutine {
+			var abandoned bool
+			if innerResult, abandoned = e.applyAbandonable(innerFn, execInternal, exceeded); abandoned {
+				return e.PostExecute(execInternal, result.Load())
+			}
+		} else {
+			innerResult = innerFn(execInternal)
+		}
+		if result.CompareAndSwap(nil, innerResult) {
 			timer.Stop()
+		} else if e.config.useReturnedResult {
+			// Return the result from the canceled execution along with the timeout error
+			timeoutResult := *result.Load()
+			timeoutResult.Result = innerResult.Result
+			return e.PostExecute(execInternal, &timeoutResult)
 		}
 		return e.PostExecute(execInternal, result.Load())
 	}
 }
 
+// schedule calls f after the timeLimit, via any configured timerFunc, else the clock, and returns a timer that can be
+// stopped.
+func (e *timeoutExecutor[R]) schedule(timeLimit time.Duration, f func()) interface{ Stop() bool } {
+	if e.config.timerFunc != nil {
+		return e.config.timerFunc(timeLimit, f)
+	}
+	return e.config.clock.AfterFunc(timeLimit, f)
+}
+
+// applyAbandonable performs the innerFn in a separate goroutine and returns its result, else returns true if the
+// attempt was abandoned because the exceeded channel was closed first.
+func (e *timeoutExecutor[R]) applyAbandonable(innerFn func(failsafe.Execution[R]) *common.PolicyResult[R], exec failsafe.Execution[R], exceeded <-chan struct{}) (*common.PolicyResult[R], bool) {
+	const running, returned, abandoned = 0, 1, 2
+	var state atomic.Int32
+	resultChan := make(chan *common.PolicyResult[R], 1)
+	go func() {
+		resultChan <- innerFn(exec)
+		if !state.CompareAndSwap(running, returned) {
+			e.abandoned.Add(-1)
+		}
+	}()
+
+	select {
+	case innerResult := <-resultChan:
+		return innerResult, false
+	case <-exceeded:
+		if !state.CompareAndSwap(running, abandoned) {
+			// The attempt returned before it could be abandoned
+			return <-resultChan, false
+		}
+		count := int(e.abandoned.Add(1))
+		if count > e.config.maxAbandoned && e.config.onAbandonedExceeded != nil {
+			e.config.onAbandonedExceeded(AbandonedExceededEvent{
+				Abandoned:    count,
+				MaxAbandoned: e.config.maxAbandoned,
+			})
+		}
+		return nil, true
+	}
+}
+
 func (e *timeoutExecutor[R]) IsFailure(_ R, err error) bool {
 	return err != nil && errors.Is(err, ErrExceeded)
 }
@@ -0,0 +1,84 @@
+package failsafe
+
+import (
+	"sync/atomic"
+
+	"github.com/failsafe-go/failsafe-go/common"
+)
+
+// ToggleablePolicy is a Policy that wraps another Policy and can be enabled or disabled at runtime, without rebuilding
+// any Executors that use it. When disabled, executions pass through to the policies or func that the Policy is composed
+// around, without the wrapped Policy's handling being applied. ToggleablePolicies are enabled by default.
+//
+// Enabling or disabling takes effect for each attempt that reaches the Policy after the change, including attempts of
+// executions that are already in progress. For example, disabling a RetryPolicy prevents further retries from being
+// performed. The state of a stateful policy, such as a CircuitBreaker, is not changed while it's disabled.
+//
+// This type is concurrency safe.
+type ToggleablePolicy[R any] interface {
+	Policy[R]
+
+	// SetEnabled sets whether the Policy is enabled.
+	SetEnabled(enabled bool)
+
+	// IsEnabled returns whether the Policy is enabled.
+	IsEnabled() bool
+}
+
+// Toggleable returns a ToggleablePolicy that wraps the policy, allowing it to be enabled or disabled at runtime.
+func Toggleable[R any](policy Policy[R]) ToggleablePolicy[R] {
+	return &toggleablePolicy[R]{
+		policy: policy,
+	}
+}
+
+type toggleablePolicy[R any] struct {
+	policy   Policy[R]
+	disabled atomic.Bool
+}
+
+func (p *toggleablePolicy[R]) SetEnabled(enabled bool) {
+	p.disabled.Store(!enabled)
+}
+
+func (p *toggleablePolicy[R]) IsEnabled() bool {
+	return !p.disabled.Load()
+}
+
+func (p *toggleablePolicy[R]) ToExecutor(typeToken R) any {
+	return &toggleableExecutor[R]{
+		toggleablePolicy: p,
+		policyExecutor:   p.policy.ToExecutor(typeToken).(policyExecutor[R]),
+	}
+}
+
+// unwrap returns the wrapped policy.
+func (p *toggleablePolicy[R]) unwrap() any {
+	return p.policy
+}
+
+// toggleableExecutor applies the wrapped policy's executor when the policy is enabled, else passes through to the innerFn.
+type toggleableExecutor[R any] struct {
+	*toggleablePolicy[R]
+	policyExecutor policyExecutor[R]
+}
+
+func (e *toggleableExecutor[R]) Apply(innerFn func(Execution[R]) *common.PolicyResult[R]) func(Execution[R]) *common.PolicyResult[R] {
+	// Inner policies are not affected by this policy being disabled
+	policyFn := e.policyExecutor.Apply(func(exec Execution[R]) *common.PolicyResult[R] {
+		c := exec.(*execution[R]).copy()
+		c.disabled = nil
+		return innerFn(c)
+	})
+
+	return func(exec Execution[R]) *common.PolicyResult[R] {
+		if e.disabled.Load() {
+			return innerFn(exec)
+		}
+
+		// Allow the policy to pass through results if it's disabled during the execution
+		c := exec.(*execution[R]).copy()
+		c.disabled = &e.disabled
+		return policyFn(c)
+	}
+}
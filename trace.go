@@ -0,0 +1,101 @@
+package failsafe
+
+import (
+	"sync"
+	"time"
+
+	"github.com/failsafe-go/failsafe-go/common"
+)
+
+// ExecutionTrace is a trace of how an execution traversed its policies, which is useful for debugging policy
+// compositions. See Executor.GetWithTrace.
+type ExecutionTrace[R any] struct {
+	// The time that the execution started at
+	StartTime time.Time
+	// The duration of the execution
+	Duration time.Duration
+	// The spans for the outermost policy, else for the execution's func attempts if there are no policies
+	Spans []*TraceSpan[R]
+}
+
+// TraceSpan records a policy's handling of an execution, or an attempt of the execution's func, within an
+// ExecutionTrace. A policy's span contains a child span for each time it called the policy or func that it's composed
+// around.
+type TraceSpan[R any] struct {
+	// The type of the policy, as the name of the package that the policy belongs to, else "" for an attempt of the
+	// execution's func
+	Policy string
+	// The index of the policy, in the order the policies were provided to the Executor, else -1 for an attempt of the
+	// execution's func
+	PolicyIndex int
+	// The time that the span started at
+	StartTime time.Time
+	// The duration of the span
+	Duration time.Duration
+	// The time between the end of the previous sibling span, or the start of the parent span, and the start of this span,
+	// such as a retry delay
+	Delay time.Duration
+	// The result returned from the span, else the zero value for R
+	Result R
+	// The error returned from the span, else nil
+	Error error
+	// Spans for the policy or func that the span's policy is composed around
+	Children []*TraceSpan[R]
+
+	// Shared across spans in a trace
+	mtx *sync.Mutex
+}
+
+func newTraceSpan[R any]() *TraceSpan[R] {
+	return &TraceSpan[R]{
+		PolicyIndex: -1,
+		StartTime:   time.Now(),
+		mtx:         &sync.Mutex{},
+	}
+}
+
+// startChild starts and returns a new child span for the policy type and index.
+func (s *TraceSpan[R]) startChild(policy string, index int) *TraceSpan[R] {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	now := time.Now()
+	prevEndTime := s.StartTime
+	if len(s.Children) > 0 {
+		prevSpan := s.Children[len(s.Children)-1]
+		prevEndTime = prevSpan.StartTime.Add(prevSpan.Duration)
+	}
+	child := &TraceSpan[R]{
+		Policy:      policy,
+		PolicyIndex: index,
+		StartTime:   now,
+		Delay:       max(0, now.Sub(prevEndTime)),
+		mtx:         s.mtx,
+	}
+	s.Children = append(s.Children, child)
+	return child
+}
+
+// end ends the span with the result.
+func (s *TraceSpan[R]) end(result *common.PolicyResult[R]) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.Duration = time.Since(s.StartTime)
+	if result != nil {
+		s.Result = result.Result
+		s.Error = result.Error
+	}
+}
+
+// applyWithTrace returns a func that records a child span for each call to the fn, which is applied for the policy type
+// and index.
+func applyWithTrace[R any](policy string, index int, fn func(Execution[R]) *common.PolicyResult[R]) func(Execution[R]) *common.PolicyResult[R] {
+	return func(exec Execution[R]) *common.PolicyResult[R] {
+		execInternal := exec.(*execution[R])
+		span := execInternal.traceSpan.startChild(policy, index)
+		c := execInternal.copy()
+		c.traceSpan = span
+		result := fn(c)
+		span.end(result)
+		return result
+	}
+}
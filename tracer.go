@@ -0,0 +1,74 @@
+package failsafe
+
+import "context"
+
+// Tracer starts spans for executions and their attempts, such as with OpenTelemetry. Implementations must be safe for
+// concurrent use. See Executor.WithTracer.
+type Tracer interface {
+	// StartSpan starts a span with the name as a child of any span in the ctx, and returns a context that contains the
+	// new span.
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a span that was started by a Tracer. Implementations must be safe for concurrent use, since events may be
+// added by concurrent attempts, such as hedges.
+type Span interface {
+	// SetAttribute sets an attribute with the key and value on the span, where the value is a bool, int, or string.
+	SetAttribute(key string, value any)
+
+	// AddEvent records an event with the name on the span, such as a policy outcome.
+	AddEvent(name string)
+
+	// End ends the span, recording the err if it's not nil.
+	End(err error)
+}
+
+// Span names and attributes that are recorded by an Executor.
+const (
+	// SpanExecution is the name of the span for an execution, which records the "failsafe.attempts" and
+	// "failsafe.success" attributes.
+	SpanExecution = "failsafe.execution"
+
+	// SpanAttempt is the name of the span for an attempt of an execution's func, which is a child of the execution's
+	// span, and records the "failsafe.attempt", "failsafe.retry", and "failsafe.hedge" attributes.
+	SpanAttempt = "failsafe.attempt"
+)
+
+// spanMetrics is a Metrics that records policy outcomes as events on an execution's span, and publishes metrics to any
+// configured Metrics.
+type spanMetrics struct {
+	metrics Metrics
+	span    Span
+}
+
+var _ Metrics = &spanMetrics{}
+
+func (m *spanMetrics) IncrementCounter(name string, labels map[string]string) {
+	switch name {
+	case MetricRetries:
+		m.span.AddEvent("retry scheduled")
+	case MetricHedges:
+		m.span.AddEvent("hedge started")
+	case MetricTimeoutsExceeded:
+		m.span.AddEvent("timeout exceeded")
+	case MetricRejections:
+		m.span.AddEvent(labels["policy"] + " rejected")
+	case MetricCircuitBreakerTransitions:
+		m.span.AddEvent("circuit breaker " + labels["state"])
+	}
+	if m.metrics != nil {
+		m.metrics.IncrementCounter(name, labels)
+	}
+}
+
+func (m *spanMetrics) SetGauge(name string, value float64, labels map[string]string) {
+	if m.metrics != nil {
+		m.metrics.SetGauge(name, value, labels)
+	}
+}
+
+func (m *spanMetrics) ObserveHistogram(name string, value float64, labels map[string]string) {
+	if m.metrics != nil {
+		m.metrics.ObserveHistogram(name, value, labels)
+	}
+}